@@ -0,0 +1,22 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/account-configuration/account_settings/
+
+data "zendesk_locale" "english" {
+  locale = "en-US"
+}
+
+data "zendesk_locale" "japanese" {
+  locale = "ja"
+}
+
+data "zendesk_locale" "german" {
+  locale = "de"
+}
+
+resource "zendesk_account_locales" "account" {
+  default_locale_id = data.zendesk_locale.english.locale_id
+  locale_ids = [
+    data.zendesk_locale.japanese.locale_id,
+    data.zendesk_locale.german.locale_id,
+  ]
+}
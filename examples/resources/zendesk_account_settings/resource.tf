@@ -0,0 +1,17 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/account-configuration/account_settings/
+
+resource "zendesk_account_settings" "account" {
+  tickets {
+    comments_public_by_default           = false
+    agent_collision                      = true
+    tagging                              = true
+    follower_and_email_cc_collaborations = true
+    ticket_followers_allowed             = true
+  }
+
+  side_conversations {
+    email_channel   = true
+    tickets_channel = true
+  }
+}
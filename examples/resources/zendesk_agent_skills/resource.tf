@@ -0,0 +1,36 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#set-agent-attribute-values
+
+variable "agent_id" {
+  type = number
+}
+
+resource "zendesk_agent_skills" "miles-dyson" {
+  agent_id = var.agent_id
+  attribute_value_ids = [
+    zendesk_routing_attribute_value.english.value_id,
+    zendesk_routing_attribute_value.T-800.value_id,
+  ]
+}
+
+# Tickets are then routed to agents with the skills set by triggers.
+resource "zendesk_trigger" "japanese-skill" {
+  title = "Route Japanese tickets"
+
+  all {
+    field    = "update_type"
+    operator = "is"
+    value    = "Create"
+  }
+
+  all {
+    field    = "current_tags"
+    operator = "includes"
+    value    = "japanese"
+  }
+
+  action {
+    field = "set_skills"
+    value = jsonencode([zendesk_routing_attribute_value.japanese.value_id])
+  }
+}
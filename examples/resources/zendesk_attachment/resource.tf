@@ -9,5 +9,15 @@ variable "logo_file_path" {
 resource "zendesk_attachment" "logo" {
   file_name = "street.jpg"
   file_path = var.logo_file_path
-  file_hash = filesha256(var.logo_file_path)
+}
+
+resource "zendesk_attachment" "logo-inline" {
+  file_name      = "street-inline.jpg"
+  content_base64 = filebase64(var.logo_file_path)
+  content_type   = "image/jpeg"
+}
+
+resource "zendesk_attachment" "notes" {
+  file_name = "notes.txt"
+  content   = "Uploaded by Terraform"
 }
@@ -2,8 +2,9 @@
 #   https://developer.zendesk.com/rest_api/docs/support/automations
 
 resource "zendesk_automation" "auto-close-automation" {
-  title  = "Close ticket 4 days after status is set to solved"
-  active = true
+  title       = "Close ticket 4 days after status is set to solved"
+  description = "Solved tickets are closed once the requester has not replied for 4 days."
+  active      = true
 
   all {
     field = "status"
@@ -5,7 +5,8 @@ resource "zendesk_brand" "T-800" {
   name            = "T-800"
   active          = true
   subdomain       = "d3v-terraform-provider-t800"
-  # TODO: logo
+  logo_file_path  = "../zendesk/testdata/street.jpg"
+  logo_file_hash  = filesha256("../zendesk/testdata/street.jpg")
 }
 
 resource "zendesk_brand" "T-1000" {
@@ -0,0 +1,7 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/account-configuration/brands/
+
+resource "zendesk_brand_agent_signature" "T-1000" {
+  brand_id           = zendesk_brand.T-1000.id
+  signature_template = "{{agent.signature}}\n\nT-1000 Support"
+}
@@ -0,0 +1,14 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_forms/
+
+resource "zendesk_ticket_form" "t800-form" {
+  name          = "T-800 Form"
+  in_all_brands = false
+}
+
+resource "zendesk_brand_ticket_forms" "T-800" {
+  brand_id = zendesk_brand.T-800.id
+  ticket_form_ids = [
+    zendesk_ticket_form.t800-form.id,
+  ]
+}
@@ -0,0 +1,14 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/custom-data/custom-objects/custom_objects/
+
+resource "zendesk_custom_object" "asset" {
+  key                  = "asset"
+  title                = "Asset"
+  title_pluralized     = "Assets"
+  description          = "Hardware lent to customers."
+  include_in_list_view = true
+}
+
+data "zendesk_custom_object" "asset" {
+  key = zendesk_custom_object.asset.key
+}
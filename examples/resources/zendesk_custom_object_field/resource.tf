@@ -0,0 +1,35 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/custom-data/custom-objects/custom_object_fields/
+
+resource "zendesk_custom_object_field" "asset-serial-number" {
+  custom_object_key     = zendesk_custom_object.asset.key
+  key                   = "serial_number"
+  type                  = "regexp"
+  title                 = "Serial number"
+  regexp_for_validation = "^[A-Z]{2}[0-9]{6}$"
+}
+
+resource "zendesk_custom_object_field" "asset-model" {
+  custom_object_key = zendesk_custom_object.asset.key
+  key               = "model"
+  type              = "dropdown"
+  title             = "Model"
+
+  custom_field_option {
+    name  = "T-800"
+    value = "t800"
+  }
+
+  custom_field_option {
+    name  = "T-1000"
+    value = "t1000"
+  }
+}
+
+resource "zendesk_custom_object_field" "asset-owner" {
+  custom_object_key        = zendesk_custom_object.asset.key
+  key                      = "owner"
+  type                     = "lookup"
+  title                    = "Owner"
+  relationship_target_type = "zen:user"
+}
@@ -0,0 +1,13 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/custom-data/custom-objects/custom_object_records/
+
+resource "zendesk_custom_object_record" "asset-t800" {
+  custom_object_key = zendesk_custom_object.asset.key
+  external_id       = "asset-0001"
+  name              = "T-800 #0001"
+
+  custom_object_fields = {
+    (zendesk_custom_object_field.asset-serial-number.key) = "TX000001"
+    (zendesk_custom_object_field.asset-model.key)         = "t800"
+  }
+}
@@ -0,0 +1,14 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/tickets/custom_ticket_statuses/
+
+resource "zendesk_custom_ticket_status" "awaiting-engineering" {
+  status_category      = "open"
+  agent_label          = "Awaiting engineering"
+  end_user_label       = "In progress"
+  description          = "Escalated to engineering and waiting for a fix."
+  end_user_description = "We are working on a fix for your request."
+}
+
+data "zendesk_custom_ticket_status" "open" {
+  status_category = "open"
+}
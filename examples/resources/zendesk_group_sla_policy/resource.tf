@@ -0,0 +1,27 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/business-rules/group_sla_policies/
+
+resource "zendesk_group_sla_policy" "tier_one" {
+  title       = "Tier 1 ownership"
+  description = "Moderators escalate to developers within the target time."
+
+  all {
+    field    = "group_id"
+    operator = "includes"
+    value    = [zendesk_group.moderator-group.id]
+  }
+
+  policy_metrics {
+    priority       = "urgent"
+    metric         = "group_ownership_time"
+    target         = 60
+    business_hours = false
+  }
+
+  policy_metrics {
+    priority       = "normal"
+    metric         = "group_ownership_time"
+    target         = 480
+    business_hours = true
+  }
+}
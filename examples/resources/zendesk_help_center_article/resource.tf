@@ -0,0 +1,12 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/
+
+resource "zendesk_help_center_article" "t800-reset" {
+  section_id          = zendesk_help_center_section.t800-maintenance.id
+  title               = "Resetting a T-800"
+  body_file_path      = "../zendesk/testdata/article.md"
+  locale              = "en-us"
+  permission_group_id = zendesk_help_center_permission_group.cyberdyne-engineering.id
+  user_segment_id     = zendesk_help_center_user_segment.resistance.id
+  label_names         = ["t800", "reset"]
+}
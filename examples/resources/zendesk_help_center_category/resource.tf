@@ -0,0 +1,8 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/help_center/help-center-api/categories/
+
+resource "zendesk_help_center_category" "cyberdyne" {
+  name        = "Cyberdyne Systems"
+  description = "Everything about your Cyberdyne products."
+  locale      = "en-us"
+}
@@ -0,0 +1,8 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/help_center/help-center-api/permission_groups/
+
+resource "zendesk_help_center_permission_group" "cyberdyne-engineering" {
+  name    = "Cyberdyne engineering"
+  edit    = [zendesk_help_center_user_segment.cyberdyne-engineers.id]
+  publish = [zendesk_help_center_user_segment.cyberdyne-engineers.id]
+}
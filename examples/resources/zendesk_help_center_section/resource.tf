@@ -0,0 +1,16 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/help_center/help-center-api/sections/
+
+resource "zendesk_help_center_section" "t800" {
+  category_id = zendesk_help_center_category.cyberdyne.id
+  name        = "T-800"
+  locale      = "en-us"
+}
+
+resource "zendesk_help_center_section" "t800-maintenance" {
+  category_id       = zendesk_help_center_category.cyberdyne.id
+  parent_section_id = zendesk_help_center_section.t800.id
+  name              = "Maintenance"
+  description       = "Keep your T-800 in mission condition."
+  locale            = "en-us"
+}
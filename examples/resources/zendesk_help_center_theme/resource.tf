@@ -0,0 +1,14 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/help_center/help-center-api/theming/
+
+# The directory holds a complete theme, e.g. a fork of https://github.com/zendesk/copenhagen_theme
+resource "zendesk_help_center_theme" "skynet" {
+  path     = "${path.module}/theme"
+  brand_id = zendesk_brand.T-800.id
+  publish  = true
+
+  # the live theme can only be deleted once its replacement is published
+  lifecycle {
+    create_before_destroy = true
+  }
+}
@@ -0,0 +1,23 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/
+
+resource "zendesk_help_center_translation" "t800-reset-de" {
+  source_type = "article"
+  source_id   = zendesk_help_center_article.t800-reset.id
+  locale      = "de"
+  title       = "Einen T-800 zurücksetzen"
+  body        = "<p>Halten Sie den Reset-Schalter <strong>zehn Sekunden</strong> lang gedrückt.</p>"
+}
+
+# Existing translations can be imported in bulk (Terraform 1.7 or later):
+#
+# data "zendesk_help_center_translations" "t800-reset" {
+#   source_type = "article"
+#   source_id   = zendesk_help_center_article.t800-reset.id
+# }
+#
+# import {
+#   for_each = { for t in data.zendesk_help_center_translations.t800-reset.translations : t.locale => t if t.locale != "en-us" }
+#   to       = zendesk_help_center_translation.t800-reset[each.key]
+#   id       = each.value.id
+# }
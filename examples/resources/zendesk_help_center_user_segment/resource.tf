@@ -0,0 +1,15 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/help_center/help-center-api/user_segments/
+
+resource "zendesk_help_center_user_segment" "resistance" {
+  name      = "Resistance members"
+  user_type = "signed_in_users"
+  tags      = ["resistance"]
+  or_tags   = ["tech-com", "command"]
+}
+
+resource "zendesk_help_center_user_segment" "cyberdyne-engineers" {
+  name      = "Cyberdyne engineers"
+  user_type = "staff"
+  tags      = ["engineering"]
+}
@@ -0,0 +1,12 @@
+# API reference:
+#   https://support.zendesk.com/hc/en-us/articles/4408887680794
+#
+# Applying this from outside of allowed_ranges locks you out of the account.
+
+resource "zendesk_ip_restriction" "account" {
+  allowed_ranges = [
+    "192.0.2.0/24",
+    "198.51.100.17",
+  ]
+  end_user_bypass = true
+}
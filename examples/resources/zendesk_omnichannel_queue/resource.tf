@@ -0,0 +1,36 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/omnichannel/omnichannel_routing_queues/
+
+resource "zendesk_omnichannel_queue" "urgent" {
+  name              = "Urgent"
+  description       = "Urgent tickets go to developers first."
+  priority          = 2
+  order             = 1
+  primary_group_ids = [zendesk_group.developer-group.id]
+  secondary_group_ids = [
+    zendesk_group.moderator-group.id,
+  ]
+
+  all {
+    field    = "priority"
+    operator = "is"
+    value    = "urgent"
+  }
+}
+
+resource "zendesk_omnichannel_queue" "japanese" {
+  name              = "Japanese"
+  primary_group_ids = [zendesk_group.moderator-group.id]
+
+  any {
+    field    = "current_tags"
+    operator = "includes"
+    value    = "japanese"
+  }
+
+  any {
+    field    = "requester_language"
+    operator = "is"
+    value    = "ja"
+  }
+}
@@ -0,0 +1,24 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/account-configuration/remote_authentications/
+
+resource "zendesk_remote_authentication" "skynet-saml" {
+  type              = "saml"
+  name              = "Skynet SAML"
+  remote_login_url  = "https://sso.skynet.example.com/saml/login"
+  remote_logout_url = "https://sso.skynet.example.com/saml/logout"
+  fingerprint       = "9A:2B:3C:4D:5E:6F:70:81:92:A3:B4:C5:D6:E7:F8:09:1A:2B:3C:4D"
+  ip_ranges         = ["203.0.113.0/24"]
+}
+
+variable "resistance_jwt_secret" {
+  type      = string
+  sensitive = true
+  default   = "0123456789abcdef0123456789abcdef"
+}
+
+resource "zendesk_remote_authentication" "resistance-jwt" {
+  type             = "jwt"
+  name             = "Resistance JWT"
+  remote_login_url = "https://sso.resistance.example.com/jwt/login"
+  shared_secret    = var.resistance_jwt_secret
+}
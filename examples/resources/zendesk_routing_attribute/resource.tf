@@ -0,0 +1,10 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/
+
+resource "zendesk_routing_attribute" "language" {
+  name = "Language"
+}
+
+resource "zendesk_routing_attribute" "product" {
+  name = "Product"
+}
@@ -0,0 +1,17 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/
+
+resource "zendesk_routing_attribute_value" "english" {
+  attribute_id = zendesk_routing_attribute.language.id
+  name         = "English"
+}
+
+resource "zendesk_routing_attribute_value" "japanese" {
+  attribute_id = zendesk_routing_attribute.language.id
+  name         = "Japanese"
+}
+
+resource "zendesk_routing_attribute_value" "T-800" {
+  attribute_id = zendesk_routing_attribute.product.id
+  name         = "T-800"
+}
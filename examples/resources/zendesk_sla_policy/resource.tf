@@ -2,8 +2,7 @@
 #   https://developer.zendesk.com/rest_api/docs/support/sla_policies
 
 resource "zendesk_sla_policy" "incidents_sla_policy" {
-  title  = "Incidents"
-  active = true
+  title = "Incidents"
 
   all {
     field    = "type"
@@ -17,4 +16,10 @@ resource "zendesk_sla_policy" "incidents_sla_policy" {
     target = 30
     business_hours = false
   }
+
+  metric_settings {
+    first_reply_time {
+      activate_on_ticket_created_for_end_user = true
+    }
+  }
 }
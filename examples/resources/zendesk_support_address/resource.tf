@@ -0,0 +1,8 @@
+# API reference:
+#   https://developer.zendesk.com/api-reference/ticketing/account-configuration/support_addresses/
+
+resource "zendesk_support_address" "support" {
+  email    = "support@d3v-terraform-provider-t800.zendesk.com"
+  name     = "T-800 Support"
+  brand_id = zendesk_brand.T-800.id
+}
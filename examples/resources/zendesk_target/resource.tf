@@ -11,3 +11,32 @@ resource "zendesk_target" "email-target" {
   email = "john.doe@example.com"
   subject = "New ticket created"
 }
+
+# HTTP targets can be migrated to webhooks with the zendesk_target_webhook_migration data source:
+#
+# data "zendesk_target_webhook_migration" "legacy" {
+#   target_id = 360000000001
+# }
+#
+# resource "zendesk_webhook" "legacy" {
+#   name           = data.zendesk_target_webhook_migration.legacy.name
+#   endpoint       = data.zendesk_target_webhook_migration.legacy.endpoint
+#   http_method    = data.zendesk_target_webhook_migration.legacy.http_method
+#   request_format = data.zendesk_target_webhook_migration.legacy.request_format
+#   status         = data.zendesk_target_webhook_migration.legacy.status
+#   subscriptions  = data.zendesk_target_webhook_migration.legacy.subscriptions
+# }
+#
+# Reading the target again with the id of the webhook gives the values of the trigger actions:
+#
+# data "zendesk_target_webhook_migration" "legacy-actions" {
+#   target_id  = 360000000001
+#   webhook_id = zendesk_webhook.legacy.id
+# }
+#
+# Then replace the notification_target actions of the listed triggers with:
+#
+#   action {
+#     field = "notification_webhook"
+#     value = data.zendesk_target_webhook_migration.legacy-actions.trigger_actions[0].webhook_value
+#   }
@@ -52,6 +52,20 @@ resource "zendesk_ticket_field" "textarea-field" {
   type = "textarea"
 }
 
+resource "zendesk_ticket_field" "lookup-field" {
+  title                    = "Lookup Field"
+  type                     = "lookup"
+  relationship_target_type = "zen:user"
+
+  relationship_filter {
+    all {
+      field    = "role"
+      operator = "is"
+      value    = "agent"
+    }
+  }
+}
+
 data "zendesk_ticket_field" "assignee" {
   type = "assignee"
 }
@@ -0,0 +1,76 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+func dataSourceZendeskCustomObject() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resolves a custom object by its key.",
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return readCustomObjectDataSource(ctx, data, zd)
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Description: "The key of the custom object.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"title": {
+				Description: "The title of the custom object.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"title_pluralized": {
+				Description: "The pluralized title of the custom object.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"description": {
+				Description: "The description of the custom object.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"include_in_list_view": {
+				Description: "Whether the custom object is listed in the agent workspace.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"url": {
+				Description: "The API url of the custom object.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func readCustomObjectDataSource(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	key := d.Get("key").(string)
+
+	var result struct {
+		CustomObject customObject `json:"custom_object"`
+	}
+	err := getJSON(ctx, zd, fmt.Sprintf("/custom_objects/%s.json", key), &result)
+	if err != nil {
+		return diag.Errorf("unable to locate a custom object with key %s: %v", key, err)
+	}
+
+	d.SetId(result.CustomObject.Key)
+
+	err = marshalCustomObject(result.CustomObject, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,57 @@
+package zendesk
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nukosuke/go-zendesk/zendesk"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestCustomObjectDataSourceRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	c := mock.NewClient(ctrl)
+
+	m := newIdentifiableGetterSetter()
+	err := m.Set("key", "asset")
+	if err != nil {
+		t.Fatalf("Read custom object returned an error. %v", err)
+	}
+
+	out := []byte(`{"custom_object": {"key": "asset", "title": "Asset", "title_pluralized": "Assets"}}`)
+	c.EXPECT().Get(gomock.Any(), gomock.Eq("/custom_objects/asset.json")).Return(out, nil)
+
+	diags := readCustomObjectDataSource(context.Background(), m, c)
+	if len(diags) != 0 {
+		t.Fatalf("Read custom object returned an error. %v", diags)
+	}
+
+	if v := m.Id(); v != "asset" {
+		t.Fatalf("Read custom object did not set the id. Expected asset, Got %v", v)
+	}
+
+	if v := m.Get("title"); v != "Asset" {
+		t.Fatalf("Read custom object did not set title. Expected Asset, Got %v", v)
+	}
+}
+
+func TestCustomObjectDataSourceReadNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	c := mock.NewClient(ctrl)
+
+	m := newIdentifiableGetterSetter()
+	err := m.Set("key", "missing")
+	if err != nil {
+		t.Fatalf("Read custom object returned an error. %v", err)
+	}
+
+	notFound := zendesk.NewError(nil, &http.Response{StatusCode: http.StatusNotFound})
+	c.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, notFound)
+
+	diags := readCustomObjectDataSource(context.Background(), m, c)
+	if len(diags) == 0 {
+		t.Fatal("Read custom object did not return an error for a missing key")
+	}
+}
@@ -0,0 +1,99 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+func dataSourceZendeskCustomTicketStatus() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resolves the system (default) custom ticket status of a status category.",
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return readCustomTicketStatusDataSource(ctx, data, zd)
+		},
+
+		Schema: map[string]*schema.Schema{
+			"status_category": {
+				Description:  `The status category to look up. Allowed values are "new", "open", "pending", "hold", or "solved".`,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(customTicketStatusCategories, false),
+			},
+			"agent_label": {
+				Description: "The label displayed to agents.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"end_user_label": {
+				Description: "The label displayed to end users.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"description": {
+				Description: "The description of when the status should be used, displayed to agents.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"end_user_description": {
+				Description: "The description of the status, displayed to end users.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"active": {
+				Description: "Whether the custom ticket status is available to agents.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"default": {
+				Description: "Whether the custom ticket status is the default status of its status category.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func readCustomTicketStatusDataSource(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	category := d.Get("status_category").(string)
+
+	q := url.Values{}
+	q.Set("status_categories", category)
+
+	var result struct {
+		CustomStatuses []customTicketStatus `json:"custom_statuses"`
+	}
+	err := getJSON(ctx, zd, "/custom_statuses.json?"+q.Encode(), &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var found *customTicketStatus
+	for _, status := range result.CustomStatuses {
+		if status.StatusCategory == category && status.Default {
+			found = &status
+			break
+		}
+	}
+
+	if found == nil {
+		return diag.Errorf("unable to locate the default custom ticket status of category: %s", category)
+	}
+
+	d.SetId(fmt.Sprintf("%d", found.ID))
+
+	err = marshalCustomTicketStatus(*found, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
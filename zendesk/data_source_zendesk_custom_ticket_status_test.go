@@ -0,0 +1,57 @@
+package zendesk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestCustomTicketStatusDataSourceRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	c := mock.NewClient(ctrl)
+
+	m := newIdentifiableGetterSetter()
+	err := m.Set("status_category", "open")
+	if err != nil {
+		t.Fatalf("Read custom ticket status returned an error. %v", err)
+	}
+
+	out := []byte(`{"custom_statuses": [
+		{"id": 1, "status_category": "open", "agent_label": "Awaiting engineering", "active": true, "default": false},
+		{"id": 2, "status_category": "open", "agent_label": "Open", "active": true, "default": true}
+	]}`)
+	c.EXPECT().Get(gomock.Any(), gomock.Eq("/custom_statuses.json?status_categories=open")).Return(out, nil)
+
+	diags := readCustomTicketStatusDataSource(context.Background(), m, c)
+	if len(diags) != 0 {
+		t.Fatalf("Read custom ticket status returned an error. %v", diags)
+	}
+
+	if v := m.Id(); v != "2" {
+		t.Fatalf("Read custom ticket status did not resolve the default status. Expected 2, Got %v", v)
+	}
+
+	if v := m.Get("agent_label"); v != "Open" {
+		t.Fatalf("Read custom ticket status did not set agent_label. Expected Open, Got %v", v)
+	}
+}
+
+func TestCustomTicketStatusDataSourceReadNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	c := mock.NewClient(ctrl)
+
+	m := newIdentifiableGetterSetter()
+	err := m.Set("status_category", "hold")
+	if err != nil {
+		t.Fatalf("Read custom ticket status returned an error. %v", err)
+	}
+
+	c.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte(`{"custom_statuses": []}`), nil)
+
+	diags := readCustomTicketStatusDataSource(context.Background(), m, c)
+	if len(diags) == 0 {
+		t.Fatal("Read custom ticket status did not return an error for a missing category")
+	}
+}
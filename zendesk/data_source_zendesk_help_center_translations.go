@@ -0,0 +1,135 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// Number of translations fetched per page while listing
+const helpCenterTranslationsPerPage = 100
+
+func dataSourceZendeskHelpCenterTranslations() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists the translations of a Help Center article, section or category, " +
+			"e.g. to import them into `zendesk_help_center_translation` resources with `import` blocks.",
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return readHelpCenterTranslationsDataSource(ctx, data, zd)
+		},
+
+		Schema: map[string]*schema.Schema{
+			"source_type": {
+				Description:  "The type of the translated item: `article`, `section` or `category`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"article", "section", "category"}, false),
+			},
+			"source_id": {
+				Description: "The id of the translated item.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"translations": {
+				Description: "The translations of the item, ordered by locale.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The id of the translation as imported into `zendesk_help_center_translation`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"locale": {
+							Description: "The locale of the translation.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"title": {
+							Description: "The title of the translation.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"draft": {
+							Description: "Whether the translation is a draft.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"outdated": {
+							Description: "Whether the translation is flagged as outdated.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readHelpCenterTranslationsDataSource(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	sourceType := d.Get("source_type").(string)
+	sources, sourceID := helpCenterTranslationSource(d)
+
+	list, err := listHelpCenterTranslations(ctx, zd, sources, sourceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Locale < list[j].Locale
+	})
+
+	translations := make([]map[string]interface{}, 0, len(list))
+	for _, t := range list {
+		translations = append(translations, map[string]interface{}{
+			"id":       fmt.Sprintf("%s/%d/%s", sourceType, sourceID, t.Locale),
+			"locale":   t.Locale,
+			"title":    t.Title,
+			"draft":    t.Draft,
+			"outdated": t.Outdated,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", sourceType, sourceID))
+
+	err = setSchemaFields(d, map[string]interface{}{
+		"translations": translations,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// listHelpCenterTranslations returns the translations of the source from all pages
+func listHelpCenterTranslations(ctx context.Context, zd client.BaseAPI, sources string, sourceID int64) ([]helpCenterTranslation, error) {
+	var translations []helpCenterTranslation
+
+	for page := 1; ; page++ {
+		var result struct {
+			Translations []helpCenterTranslation `json:"translations"`
+			client.Page
+		}
+		err := getJSON(ctx, zd, fmt.Sprintf("/help_center/%s/%d/translations.json?page=%d&per_page=%d", sources, sourceID, page, helpCenterTranslationsPerPage), &result)
+		if err != nil {
+			return nil, err
+		}
+
+		translations = append(translations, result.Translations...)
+		if !result.HasNext() {
+			break
+		}
+	}
+
+	return translations, nil
+}
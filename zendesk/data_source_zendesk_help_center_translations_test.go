@@ -0,0 +1,72 @@
+package zendesk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestHelpCenterTranslationsDataSourceRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	c := mock.NewClient(ctrl)
+
+	m := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"source_type": "article",
+			"source_id":   4567,
+		},
+	}
+
+	out := []byte(`{"translations": [
+		{"id": 9877, "locale": "fr", "title": "Réinitialiser un T-800", "draft": true},
+		{"id": 9875, "locale": "en-us", "title": "Resetting a T-800"},
+		{"id": 9876, "locale": "de", "title": "Einen T-800 zurücksetzen", "outdated": true}
+	]}`)
+	c.EXPECT().Get(gomock.Any(), gomock.Eq("/help_center/articles/4567/translations.json?page=1&per_page=100")).Return(out, nil)
+
+	diags := readHelpCenterTranslationsDataSource(context.Background(), m, c)
+	if len(diags) != 0 {
+		t.Fatalf("Read help center translations returned an error. %v", diags)
+	}
+
+	if v := m.Id(); v != "article/4567" {
+		t.Fatalf("Read help center translations did not set the id. Expected article/4567, Got %v", v)
+	}
+
+	translations := m.Get("translations").([]map[string]interface{})
+	if len(translations) != 3 {
+		t.Fatalf("Read help center translations returned %d translations. Expected 3", len(translations))
+	}
+
+	if v := translations[0]["id"]; v != "article/4567/de" {
+		t.Fatalf("Read help center translations did not order by locale. Expected article/4567/de first, Got %v", v)
+	}
+
+	if v := translations[0]["outdated"]; v != true {
+		t.Fatalf("Read help center translations did not set outdated. Got %v", v)
+	}
+}
+
+func TestListHelpCenterTranslationsPaginates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c := mock.NewClient(ctrl)
+	gomock.InOrder(
+		c.EXPECT().Get(gomock.Any(), gomock.Eq("/help_center/sections/12/translations.json?page=1&per_page=100")).
+			Return([]byte(`{"translations": [{"locale": "en-us"}], "next_page": "https://example.zendesk.com/api/v2/help_center/sections/12/translations.json?page=2&per_page=100"}`), nil),
+		c.EXPECT().Get(gomock.Any(), gomock.Eq("/help_center/sections/12/translations.json?page=2&per_page=100")).
+			Return([]byte(`{"translations": [{"locale": "de"}], "next_page": null}`), nil),
+	)
+
+	translations, err := listHelpCenterTranslations(context.Background(), c, "sections", 12)
+	if err != nil {
+		t.Fatalf("listHelpCenterTranslations returned an error: %v", err)
+	}
+
+	if len(translations) != 2 {
+		t.Fatalf("listHelpCenterTranslations returned %d translations. Expected 2", len(translations))
+	}
+}
@@ -0,0 +1,73 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+func dataSourceZendeskLocale() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resolves a locale code, like `ja` or `pt-br`, to the locale id expected by the APIs. Any locale Zendesk supports can be resolved, enabled in the account or not.",
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return readLocaleDataSource(ctx, data, zd)
+		},
+
+		Schema: map[string]*schema.Schema{
+			"locale": {
+				Description: "The code of the locale, case insensitive.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"locale_id": {
+				Description: "The id of the locale.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"name": {
+				Description: "The name of the locale.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func readLocaleDataSource(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	code := d.Get("locale").(string)
+
+	var result struct {
+		Locales []client.Locale `json:"locales"`
+	}
+	err := getJSON(ctx, zd, "/locales/public.json", &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, l := range result.Locales {
+		if !strings.EqualFold(l.Locale, code) {
+			continue
+		}
+
+		d.SetId(fmt.Sprintf("%d", l.ID))
+
+		err = setSchemaFields(d, map[string]interface{}{
+			"locale_id": l.ID,
+			"name":      l.Name,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		return diags
+	}
+
+	return diag.Errorf("could not find the locale %s", code)
+}
@@ -0,0 +1,44 @@
+package zendesk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestLocaleDataSourceRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	c := mock.NewClient(ctrl)
+
+	out := []byte(`{"locales": [
+		{"id": 1, "locale": "en-US", "name": "English"},
+		{"id": 67, "locale": "ja", "name": "Japanese (日本語)"}
+	]}`)
+	c.EXPECT().Get(gomock.Any(), gomock.Eq("/locales/public.json")).Return(out, nil).Times(2)
+
+	m := newIdentifiableGetterSetter()
+	if err := m.Set("locale", "en-us"); err != nil {
+		t.Fatalf("Read locale returned an error. %v", err)
+	}
+
+	diags := readLocaleDataSource(context.Background(), m, c)
+	if len(diags) != 0 {
+		t.Fatalf("Read locale returned an error. %v", diags)
+	}
+
+	if v := m.Get("locale_id"); v != int64(1) {
+		t.Fatalf("Read locale did not match the code case insensitively. Expected 1, Got %v", v)
+	}
+
+	m = newIdentifiableGetterSetter()
+	if err := m.Set("locale", "tlh"); err != nil {
+		t.Fatalf("Read locale returned an error. %v", err)
+	}
+
+	diags = readLocaleDataSource(context.Background(), m, c)
+	if len(diags) != 1 {
+		t.Fatalf("Read locale did not return an error for an unknown locale. %v", diags)
+	}
+}
@@ -0,0 +1,244 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// targetWebhookMigrationAPI is the part of the client used by the target webhook migration data source.
+// Triggers are listed to find the actions notifying the target.
+type targetWebhookMigrationAPI interface {
+	client.TargetAPI
+	client.TriggerAPI
+}
+
+// Number of triggers fetched per page while listing
+const triggersPerPage = 100
+
+// The request formats of webhooks by content type of HTTP targets
+var targetWebhookRequestFormats = map[string]string{
+	"application/json":                  "json",
+	"application/xml":                   "xml",
+	"application/x-www-form-urlencoded": "form_encoded",
+}
+
+// https://support.zendesk.com/hc/en-us/articles/4408826284698
+func dataSourceZendeskTargetWebhookMigration() *schema.Resource {
+	return &schema.Resource{
+		Description: "Reads an HTTP target and returns the attributes of the equivalent `zendesk_webhook`, " +
+			"along with the values of the trigger actions notifying the target, rewritten to notify the webhook.",
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(*client.Client)
+			return readTargetWebhookMigrationDataSource(ctx, data, zd)
+		},
+
+		Schema: map[string]*schema.Schema{
+			"target_id": {
+				Description: "The id of the HTTP target to migrate.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"webhook_id": {
+				Description: "The id of the webhook replacing the target, used in `trigger_actions`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"name": {
+				Description: "The name of the webhook.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"endpoint": {
+				Description: "The endpoint of the webhook.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"http_method": {
+				Description: "The HTTP method of the webhook.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"request_format": {
+				Description: "The request format of the webhook.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status": {
+				Description: "The status of the webhook.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"subscriptions": {
+				Description: "The subscriptions of the webhook, connecting it to triggers.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"basic_auth_username": {
+				Description: "The username of the `basic_auth` authentication of the webhook, empty when the target has none. " +
+					"The password of the target can't be read and must be set again.",
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"trigger_actions": {
+				Description: "The trigger actions notifying the target.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"trigger_id": {
+							Description: "The id of the trigger.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"trigger_title": {
+							Description: "The title of the trigger.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"target_value": {
+							Description: "The value of the current `notification_target` action.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"webhook_value": {
+							Description: "The value of the `notification_webhook` action replacing it. Empty when `webhook_id` is not set.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// listTriggers fetches every trigger of the account
+func listTriggers(ctx context.Context, zd client.TriggerAPI) ([]client.Trigger, error) {
+	var triggers []client.Trigger
+
+	opts := &client.TriggerListOptions{
+		PageOptions: client.PageOptions{
+			PerPage: triggersPerPage,
+			Page:    1,
+		},
+	}
+
+	for {
+		page, p, err := zd.GetTriggers(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		triggers = append(triggers, page...)
+		if !p.HasNext() {
+			break
+		}
+		opts.Page++
+	}
+
+	return triggers, nil
+}
+
+// targetWebhookTriggerActions returns the trigger actions notifying the target, with the values notifying the webhook instead.
+// notification_target values are [target_id, message] and notification_webhook values are [webhook_id, body].
+func targetWebhookTriggerActions(triggers []client.Trigger, targetID int64, webhookID string) ([]map[string]interface{}, error) {
+	actions := make([]map[string]interface{}, 0)
+
+	for _, trigger := range triggers {
+		for _, action := range trigger.Actions {
+			if action.Field != "notification_target" {
+				continue
+			}
+
+			values, ok := action.Value.([]interface{})
+			if !ok || len(values) == 0 || fmt.Sprint(values[0]) != fmt.Sprint(targetID) {
+				continue
+			}
+
+			targetValue, err := json.Marshal(values)
+			if err != nil {
+				return nil, fmt.Errorf("error decoding trigger action value: %s", err)
+			}
+
+			var webhookValue []byte
+			if webhookID != "" {
+				webhookValue, err = json.Marshal(append([]interface{}{webhookID}, values[1:]...))
+				if err != nil {
+					return nil, fmt.Errorf("error decoding trigger action value: %s", err)
+				}
+			}
+
+			actions = append(actions, map[string]interface{}{
+				"trigger_id":    trigger.ID,
+				"trigger_title": trigger.Title,
+				"target_value":  string(targetValue),
+				"webhook_value": string(webhookValue),
+			})
+		}
+	}
+
+	return actions, nil
+}
+
+func readTargetWebhookMigrationDataSource(ctx context.Context, d identifiableGetterSetter, zd targetWebhookMigrationAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	targetID := int64(d.Get("target_id").(int))
+
+	target, err := zd.GetTarget(ctx, targetID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if target.Type != "http_target" && target.Type != "url_target_v2" {
+		return diag.Errorf("target %d is an %s. only HTTP targets can be migrated to webhooks", targetID, target.Type)
+	}
+
+	requestFormat := "json"
+	if v, ok := targetWebhookRequestFormats[target.ContentType]; ok {
+		requestFormat = v
+	}
+
+	status := "inactive"
+	if target.Active {
+		status = "active"
+	}
+
+	triggers, err := listTriggers(ctx, zd)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	webhookID, _ := d.Get("webhook_id").(string)
+	actions, err := targetWebhookTriggerActions(triggers, targetID, webhookID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", targetID))
+
+	err = setSchemaFields(d, map[string]interface{}{
+		"name":                target.Title,
+		"endpoint":            target.TargetURL,
+		"http_method":         strings.ToUpper(target.Method),
+		"request_format":      requestFormat,
+		"status":              status,
+		"subscriptions":       []string{"conditional_ticket_events"},
+		"basic_auth_username": target.Username,
+		"trigger_actions":     actions,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
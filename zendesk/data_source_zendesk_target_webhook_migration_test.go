@@ -0,0 +1,127 @@
+package zendesk
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/nukosuke/go-zendesk/zendesk"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestTargetWebhookMigrationDataSourceRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	m.EXPECT().GetTarget(gomock.Any(), gomock.Eq(int64(1234))).Return(zendesk.Target{
+		ID:          1234,
+		Type:        "http_target",
+		Title:       "Legacy notifier",
+		Active:      true,
+		TargetURL:   "https://example.com/notify",
+		Method:      "post",
+		Username:    "zendesk",
+		ContentType: "application/x-www-form-urlencoded",
+	}, nil)
+
+	nextPage := "https://example.zendesk.com/api/v2/triggers.json?page=2"
+	m.EXPECT().GetTriggers(gomock.Any(), gomock.Any()).Return([]zendesk.Trigger{
+		{
+			ID:    1,
+			Title: "Notify legacy",
+			Actions: []zendesk.TriggerAction{
+				{Field: "status", Value: "open"},
+				{Field: "notification_target", Value: []interface{}{"1234", "ticket={{ticket.id}}"}},
+			},
+		},
+	}, zendesk.Page{NextPage: &nextPage}, nil)
+	m.EXPECT().GetTriggers(gomock.Any(), gomock.Any()).Return([]zendesk.Trigger{
+		{
+			ID:    2,
+			Title: "Notify another target",
+			Actions: []zendesk.TriggerAction{
+				{Field: "notification_target", Value: []interface{}{"5678", "ticket={{ticket.id}}"}},
+			},
+		},
+	}, zendesk.Page{}, nil)
+
+	d := newIdentifiableGetterSetter()
+	if err := d.Set("target_id", 1234); err != nil {
+		t.Fatalf("Read target webhook migration returned an error. %v", err)
+	}
+	if err := d.Set("webhook_id", "01GDXYD7ZTWYP6M8S4SSWH8KFJ"); err != nil {
+		t.Fatalf("Read target webhook migration returned an error. %v", err)
+	}
+
+	if diags := readTargetWebhookMigrationDataSource(context.Background(), d, m); len(diags) != 0 {
+		t.Fatalf("Read target webhook migration returned an error. %v", diags)
+	}
+
+	expected := map[string]interface{}{
+		"name":                "Legacy notifier",
+		"endpoint":            "https://example.com/notify",
+		"http_method":         "POST",
+		"request_format":      "form_encoded",
+		"status":              "active",
+		"basic_auth_username": "zendesk",
+	}
+	for k, v := range expected {
+		if got := d.Get(k); got != v {
+			t.Fatalf("Read target webhook migration set %s to %v. Expected %v", k, got, v)
+		}
+	}
+
+	actions := d.Get("trigger_actions").([]map[string]interface{})
+	if len(actions) != 1 {
+		t.Fatalf("Read target webhook migration found %d trigger actions. Expected 1", len(actions))
+	}
+	if v := actions[0]["target_value"]; v != `["1234","ticket={{ticket.id}}"]` {
+		t.Fatalf("Read target webhook migration set target_value %v", v)
+	}
+	if v := actions[0]["webhook_value"]; v != `["01GDXYD7ZTWYP6M8S4SSWH8KFJ","ticket={{ticket.id}}"]` {
+		t.Fatalf("Read target webhook migration set webhook_value %v", v)
+	}
+}
+
+func TestTargetWebhookMigrationDataSourceReadEmailTarget(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	m.EXPECT().GetTarget(gomock.Any(), gomock.Eq(int64(1234))).Return(zendesk.Target{
+		ID:    1234,
+		Type:  "email_target",
+		Email: "john.doe@example.com",
+	}, nil)
+
+	d := newIdentifiableGetterSetter()
+	if err := d.Set("target_id", 1234); err != nil {
+		t.Fatalf("Read target webhook migration returned an error. %v", err)
+	}
+
+	diags := readTargetWebhookMigrationDataSource(context.Background(), d, m)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "only HTTP targets") {
+		t.Fatalf("Read target webhook migration did not reject the email target. %v", diags)
+	}
+}
+
+func TestTargetWebhookTriggerActionsWithoutWebhook(t *testing.T) {
+	triggers := []zendesk.Trigger{
+		{
+			ID: 1,
+			Actions: []zendesk.TriggerAction{
+				{Field: "notification_target", Value: []interface{}{"1234", "{}"}},
+			},
+		},
+	}
+
+	actions, err := targetWebhookTriggerActions(triggers, 1234, "")
+	if err != nil {
+		t.Fatalf("targetWebhookTriggerActions returned an error. %v", err)
+	}
+	if len(actions) != 1 || actions[0]["webhook_value"] != "" {
+		t.Fatalf("targetWebhookTriggerActions returned %v. webhook_value should have been empty", actions)
+	}
+}
@@ -96,7 +96,7 @@ func dataSourceZendeskTicketField() *schema.Resource {
 			// Required only for "tagger" type
 			// https://developer.zendesk.com/rest_api/docs/support/ticket_fields#updating-drop-down-field-options
 			"custom_field_option": {
-				Type: schema.TypeSet,
+				Type: schema.TypeList,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -131,11 +131,25 @@ func dataSourceZendeskTicketField() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"relationship_target_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"relationship_filter": {
+				Type: schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"all": dataSourceTicketFieldRelationshipConditionSchema(),
+						"any": dataSourceTicketFieldRelationshipConditionSchema(),
+					},
+				},
+				Computed: true,
+			},
 		},
 	}
 }
 
-func readTicketFieldDataSource(ctx context.Context, d identifiableGetterSetter, zd zendesk.TicketFieldAPI) diag.Diagnostics {
+func readTicketFieldDataSource(ctx context.Context, d identifiableGetterSetter, zd ticketFieldAPI) diag.Diagnostics {
 	searchType := d.Get("type").(string)
 
 	ticketFields, _, err := zd.GetTicketFields(context.Background())
@@ -159,3 +173,26 @@ func readTicketFieldDataSource(ctx context.Context, d identifiableGetterSetter,
 	d.SetId(strconv.Itoa(int(found.ID)))
 	return readTicketField(ctx, d, zd)
 }
+
+func dataSourceTicketFieldRelationshipConditionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeSet,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"field": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"operator": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+		Computed: true,
+	}
+}
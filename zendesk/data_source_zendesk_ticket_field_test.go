@@ -38,7 +38,7 @@ func TestTicketFieldDataSourceRead(t *testing.T) {
 	}
 
 	c.EXPECT().GetTicketFields(gomock.Any()).Return([]zendesk.TicketField{out}, zendesk.Page{}, nil)
-	c.EXPECT().GetTicketField(gomock.Any(), gomock.Any()).Return(out, nil)
+	c.EXPECT().Get(gomock.Any(), gomock.Eq("/ticket_fields/1234.json")).Return([]byte(`{"ticket_field": {"id": 1234, "type": "subject", "title": "Subject", "url": "foobar"}}`), nil)
 
 	diags := readTicketFieldDataSource(context.Background(), m, c)
 	if len(diags) != 0 {
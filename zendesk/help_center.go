@@ -0,0 +1,59 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// helpCenterTranslation is the Help Center translation JSON payload format.
+// Names and descriptions of categories and sections are their translation's title and body.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#json-format
+type helpCenterTranslation struct {
+	ID         int64      `json:"id,omitempty"`
+	URL        string     `json:"url,omitempty"`
+	HTMLURL    string     `json:"html_url,omitempty"`
+	SourceID   int64      `json:"source_id,omitempty"`
+	SourceType string     `json:"source_type,omitempty"`
+	Locale     string     `json:"locale,omitempty"`
+	Title      string     `json:"title"`
+	Body       string     `json:"body"`
+	Outdated   bool       `json:"outdated"`
+	Draft      bool       `json:"draft"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+}
+
+func helpCenterLocaleSchema(desc string) *schema.Schema {
+	return &schema.Schema{
+		Description: desc + " Defaults to the default locale of the Help Center.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+	}
+}
+
+// helpCenterPath returns the path of a Help Center endpoint, in the locale when one is given
+func helpCenterPath(locale string, format string, a ...interface{}) string {
+	path := fmt.Sprintf(format, a...)
+	if locale == "" {
+		return "/help_center" + path
+	}
+	return fmt.Sprintf("/help_center/%s%s", locale, path)
+}
+
+// putHelpCenterTranslation updates the translation of an article, section or category in a locale.
+// The endpoints of these only update their metadata.
+func putHelpCenterTranslation(ctx context.Context, zd client.BaseAPI, sourceType string, sourceID int64, locale string, translation helpCenterTranslation) error {
+	var data struct {
+		Translation helpCenterTranslation `json:"translation"`
+	}
+	data.Translation = translation
+
+	return putJSON(ctx, zd, fmt.Sprintf("/help_center/%s/%d/translations/%s.json", sourceType, sourceID, locale), data, nil)
+}
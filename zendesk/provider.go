@@ -2,6 +2,7 @@ package zendesk
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -45,22 +46,50 @@ func Provider() *schema.Provider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"zendesk_automation":   resourceZendeskAutomation(),
-			"zendesk_brand":        resourceZendeskBrand(),
-			"zendesk_group":        resourceZendeskGroup(),
-			"zendesk_ticket_field": resourceZendeskTicketField(),
-			"zendesk_ticket_form":  resourceZendeskTicketForm(),
-			"zendesk_trigger":      resourceZendeskTrigger(),
-			"zendesk_target":       resourceZendeskTarget(),
-			"zendesk_attachment":   resourceZendeskAttachment(),
-			"zendesk_organization": resourceZendeskOrganization(),
-			"zendesk_sla_policy":   resourceZendeskSLAPolicy(),
-			"zendesk_webhook":      resourceZendeskWebhook(),
+			"zendesk_account_locales":              resourceZendeskAccountLocales(),
+			"zendesk_account_settings":             resourceZendeskAccountSettings(),
+			"zendesk_agent_skills":                 resourceZendeskAgentSkills(),
+			"zendesk_automation":                   resourceZendeskAutomation(),
+			"zendesk_brand":                        resourceZendeskBrand(),
+			"zendesk_brand_agent_signature":        resourceZendeskBrandAgentSignature(),
+			"zendesk_brand_ticket_forms":           resourceZendeskBrandTicketForms(),
+			"zendesk_custom_object":                resourceZendeskCustomObject(),
+			"zendesk_custom_object_field":          resourceZendeskCustomObjectField(),
+			"zendesk_custom_object_record":         resourceZendeskCustomObjectRecord(),
+			"zendesk_custom_ticket_status":         resourceZendeskCustomTicketStatus(),
+			"zendesk_group":                        resourceZendeskGroup(),
+			"zendesk_group_sla_policy":             resourceZendeskGroupSLAPolicy(),
+			"zendesk_help_center_article":          resourceZendeskHelpCenterArticle(),
+			"zendesk_help_center_category":         resourceZendeskHelpCenterCategory(),
+			"zendesk_help_center_permission_group": resourceZendeskHelpCenterPermissionGroup(),
+			"zendesk_help_center_section":          resourceZendeskHelpCenterSection(),
+			"zendesk_help_center_theme":            resourceZendeskHelpCenterTheme(),
+			"zendesk_help_center_translation":      resourceZendeskHelpCenterTranslation(),
+			"zendesk_help_center_user_segment":     resourceZendeskHelpCenterUserSegment(),
+			"zendesk_ip_restriction":               resourceZendeskIPRestriction(),
+			"zendesk_omnichannel_queue":            resourceZendeskOmnichannelQueue(),
+			"zendesk_remote_authentication":        resourceZendeskRemoteAuthentication(),
+			"zendesk_routing_attribute":            resourceZendeskRoutingAttribute(),
+			"zendesk_routing_attribute_value":      resourceZendeskRoutingAttributeValue(),
+			"zendesk_ticket_field":                 resourceZendeskTicketField(),
+			"zendesk_ticket_form":                  resourceZendeskTicketForm(),
+			"zendesk_trigger":                      resourceZendeskTrigger(),
+			"zendesk_target":                       resourceZendeskTarget(),
+			"zendesk_attachment":                   resourceZendeskAttachment(),
+			"zendesk_organization":                 resourceZendeskOrganization(),
+			"zendesk_support_address":              resourceZendeskSupportAddress(),
+			"zendesk_sla_policy":                   resourceZendeskSLAPolicy(),
+			"zendesk_webhook":                      resourceZendeskWebhook(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"zendesk_ticket_field": dataSourceZendeskTicketField(),
-			"zendesk_webhook":      dataSourceZendeskWebhook(),
+			"zendesk_custom_object":            dataSourceZendeskCustomObject(),
+			"zendesk_custom_ticket_status":     dataSourceZendeskCustomTicketStatus(),
+			"zendesk_help_center_translations": dataSourceZendeskHelpCenterTranslations(),
+			"zendesk_locale":                   dataSourceZendeskLocale(),
+			"zendesk_target_webhook_migration": dataSourceZendeskTargetWebhookMigration(),
+			"zendesk_ticket_field":             dataSourceZendeskTicketField(),
+			"zendesk_webhook":                  dataSourceZendeskWebhook(),
 		},
 
 		ConfigureContextFunc: providerConfigure,
@@ -77,7 +106,10 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	}
 
 	// Create & configure Zendesk API client
-	zd, err := client.NewClient(nil) // TODO: set UserAgent to terraform/version
+	httpClient := &http.Client{
+		Transport: &transport{base: http.DefaultTransport},
+	}
+	zd, err := client.NewClient(httpClient) // TODO: set UserAgent to terraform/version
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
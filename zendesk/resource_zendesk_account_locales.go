@@ -0,0 +1,160 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// The id of the account wide zendesk_account_locales resource
+const accountLocalesID = "account"
+
+// accountLocalization is the localization account settings JSON payload format
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/account_settings/#json-format
+type accountLocalization struct {
+	DefaultLocaleID int64   `json:"default_locale_id,omitempty"`
+	LocaleIDs       []int64 `json:"locale_ids"`
+}
+
+// https://developer.zendesk.com/api-reference/ticketing/account-configuration/account_settings/
+func resourceZendeskAccountLocales() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the default locale and the enabled locales of the account. There is a single instance per account, imported with the id `account`. " +
+			"Destroying the resource leaves the locales of the account as they are.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return setAccountLocales(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return readAccountLocales(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return setAccountLocales(ctx, d, zd)
+		},
+		DeleteContext: schema.NoopContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+				if d.Id() != accountLocalesID {
+					return nil, fmt.Errorf("could not import account locales %s: the id should be %s", d.Id(), accountLocalesID)
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, i interface{}) error {
+			return validateAccountLocales(d)
+		},
+
+		Schema: map[string]*schema.Schema{
+			"default_locale_id": {
+				Description: "The id of the default locale of the account. The `zendesk_locale` data source resolves locale ids from locale codes.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"locale_ids": {
+				Description: "The ids of the locales enabled in addition to the default locale.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Optional: true,
+			},
+		},
+	}
+}
+
+func validateAccountLocales(d getter) error {
+	defaultLocaleID, ok := d.Get("default_locale_id").(int)
+	if !ok || defaultLocaleID == 0 {
+		return nil
+	}
+
+	if v, ok := d.GetOk("locale_ids"); ok && v.(*schema.Set).Contains(defaultLocaleID) {
+		return fmt.Errorf("locale_ids should not contain the default locale %d, which is always enabled", defaultLocaleID)
+	}
+
+	return nil
+}
+
+func marshalAccountLocales(localization accountLocalization, d identifiableGetterSetter) error {
+	// the default locale is listed among the enabled locales
+	localeIDs := int64SliceRemove(localization.LocaleIDs, localization.DefaultLocaleID)
+
+	fields := map[string]interface{}{
+		"default_locale_id": localization.DefaultLocaleID,
+		"locale_ids":        localeIDs,
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+func unmarshalAccountLocales(d identifiableGetterSetter) accountLocalization {
+	localization := accountLocalization{
+		DefaultLocaleID: int64(d.Get("default_locale_id").(int)),
+	}
+
+	localization.LocaleIDs = []int64{localization.DefaultLocaleID}
+	if v, ok := d.GetOk("locale_ids"); ok {
+		for _, id := range v.(*schema.Set).List() {
+			localization.LocaleIDs = append(localization.LocaleIDs, int64(id.(int)))
+		}
+	}
+
+	return localization
+}
+
+// setAccountLocales updates the localization settings of the account
+func setAccountLocales(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data := map[string]interface{}{
+		"settings": map[string]interface{}{
+			"localization": unmarshalAccountLocales(d),
+		},
+	}
+
+	var result struct {
+		Settings struct {
+			Localization accountLocalization `json:"localization"`
+		} `json:"settings"`
+	}
+	err := putJSON(ctx, zd, "/account/settings.json", data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(accountLocalesID)
+
+	err = marshalAccountLocales(result.Settings.Localization, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readAccountLocales(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var result struct {
+		Settings struct {
+			Localization accountLocalization `json:"localization"`
+		} `json:"settings"`
+	}
+	err := getJSON(ctx, zd, "/account/settings.json", &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalAccountLocales(result.Settings.Localization, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,112 @@
+package zendesk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestSetAccountLocales(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"default_locale_id": 1,
+			"locale_ids":        schema.NewSet(schema.HashInt, []interface{}{67}),
+		},
+	}
+
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/account/settings.json"), gomock.Eq(map[string]interface{}{
+		"settings": map[string]interface{}{
+			"localization": accountLocalization{
+				DefaultLocaleID: 1,
+				LocaleIDs:       []int64{1, 67},
+			},
+		},
+	})).Return([]byte(`{"settings": {"localization": {"default_locale_id": 1, "locale_ids": [1, 67]}}}`), nil)
+
+	if diags := setAccountLocales(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("setAccountLocales returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "account" {
+		t.Fatalf("setAccountLocales did not set resource id. Id was %s", v)
+	}
+
+	// the default locale is not repeated among the additional ones
+	if v := i.Get("locale_ids").([]int64); len(v) != 1 || v[0] != 67 {
+		t.Fatalf("setAccountLocales set locale_ids %v. should have been [67]", v)
+	}
+}
+
+func TestReadAccountLocales(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("account")
+
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/account/settings.json")).
+		Return([]byte(`{"settings": {"localization": {"default_locale_id": 67, "locale_ids": [1, 8, 67]}}}`), nil)
+
+	if diags := readAccountLocales(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readAccountLocales returned an error: %v", diags)
+	}
+
+	if v := i.Get("default_locale_id"); v != int64(67) {
+		t.Fatalf("readAccountLocales set default_locale_id %v. should have been 67", v)
+	}
+
+	if v := i.Get("locale_ids").([]int64); len(v) != 2 || v[0] != 1 || v[1] != 8 {
+		t.Fatalf("readAccountLocales set locale_ids %v. should have been [1 8]", v)
+	}
+}
+
+func TestValidateAccountLocales(t *testing.T) {
+	valid := mapGetterSetter{
+		"default_locale_id": 1,
+		"locale_ids":        schema.NewSet(schema.HashInt, []interface{}{67}),
+	}
+	if err := validateAccountLocales(valid); err != nil {
+		t.Fatalf("validateAccountLocales returned an error for valid locales: %v", err)
+	}
+
+	invalid := mapGetterSetter{
+		"default_locale_id": 1,
+		"locale_ids":        schema.NewSet(schema.HashInt, []interface{}{1, 67}),
+	}
+	if err := validateAccountLocales(invalid); err == nil {
+		t.Fatal("validateAccountLocales did not return an error when the default locale was listed")
+	}
+}
+
+func TestAccAccountLocalesExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: readExampleConfig(t, "resources/zendesk_account_locales/resource.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("zendesk_account_locales.account", "default_locale_id", "data.zendesk_locale.english", "locale_id"),
+					resource.TestCheckResourceAttr("zendesk_account_locales.account", "locale_ids.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "zendesk_account_locales.account",
+				ImportState:       true,
+				ImportStateId:     "account",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,383 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// The id of the account wide zendesk_account_settings resource
+const accountSettingsID = "account"
+
+// accountSetting is a boolean account setting managed by zendesk_account_settings
+type accountSetting struct {
+	key         string
+	description string
+}
+
+// accountSettingsGroups are the groups of the account settings JSON payload managed by zendesk_account_settings
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/account_settings/#json-format
+var accountSettingsGroups = []struct {
+	name        string
+	description string
+	settings    []accountSetting
+}{
+	{
+		name:        "tickets",
+		description: "Ticket settings.",
+		settings: []accountSetting{
+			{"comments_public_by_default", "Whether new comments of agents are public by default."},
+			{"is_first_comment_private_enabled", "Whether the first comment of a ticket created by an agent can be private."},
+			{"agent_collision", "Whether agents are notified when another agent is viewing or updating the same ticket."},
+			{"tagging", "Whether tickets can be tagged."},
+			{"list_newest_comments_first", "Whether the newest comments are listed first."},
+			{"markdown_ticket_comments", "Whether agents can format comments with Markdown."},
+			{"rich_text_comments", "Whether agents can format comments with the rich text editor."},
+			{"emoji_autocompletion", "Whether emojis are autocompleted in comments."},
+			{"private_attachments", "Whether users must sign in to access attachments."},
+			{"follower_and_email_cc_collaborations", "Whether followers and email CCs are enabled."},
+			{"ticket_followers_allowed", "Whether agents can add followers to tickets."},
+			{"comment_email_ccs_allowed", "Whether CCs can be added to comments by email."},
+			{"light_agent_email_ccs_allowed", "Whether light agents can be CCed."},
+			{"agent_email_ccs_become_followers", "Whether CCed agents become followers."},
+			{"auto_updated_ccs_followers_rules", "Whether triggers and automations sending email to requesters also send it to CCs."},
+		},
+	},
+	{
+		name:        "agents",
+		description: "Agent settings.",
+		settings: []accountSetting{
+			{"agent_workspace", "Whether the Agent Workspace is enabled."},
+			{"agent_home", "Whether the agent home page is enabled."},
+			{"focus_mode", "Whether agents can use focus mode."},
+		},
+	},
+	{
+		name:        "side_conversations",
+		description: "Side conversation settings.",
+		settings: []accountSetting{
+			{"email_channel", "Whether side conversations can be sent by email."},
+			{"slack_channel", "Whether side conversations can be sent to Slack."},
+			{"msteams_channel", "Whether side conversations can be sent to Microsoft Teams."},
+			{"tickets_channel", "Whether side conversations can be child tickets."},
+			{"show_in_context_panel", "Whether side conversations are shown in the context panel."},
+		},
+	},
+}
+
+// https://developer.zendesk.com/api-reference/ticketing/account-configuration/account_settings/
+func resourceZendeskAccountSettings() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"prior_values": {
+			Description: "The values the declared settings had before they were first applied, by `<group>.<setting>`. " +
+				"They are restored when a setting is no longer declared or the resource is destroyed.",
+			Type: schema.TypeMap,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Computed: true,
+		},
+	}
+
+	for _, group := range accountSettingsGroups {
+		settings := make(map[string]*schema.Schema)
+		for _, setting := range group.settings {
+			settings[setting.key] = &schema.Schema{
+				Description: setting.description,
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			}
+		}
+
+		s[group.name] = &schema.Schema{
+			Description: group.description + " Only the declared settings are managed.",
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: settings,
+			},
+		}
+	}
+
+	return &schema.Resource{
+		Description: "Manages settings of the account. There is a single instance per account, imported with the id `account`. " +
+			"Settings which are not declared are left untouched, and declared settings get their prior values back on destroy.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return setAccountSettings(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return readAccountSettings(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return setAccountSettings(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return deleteAccountSettings(ctx, d, zd)
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, i interface{}) error {
+			// removing a setting which is Optional and Computed shows no diff, so an update
+			// is planned for its prior value to be restored
+			if d.Id() == "" || !hasUndeclaredAccountSettings(d) {
+				return nil
+			}
+			return d.SetNewComputed("prior_values")
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+				if d.Id() != accountSettingsID {
+					return nil, fmt.Errorf("could not import account settings %s: the id should be %s", d.Id(), accountSettingsID)
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: s,
+	}
+}
+
+// declaredAccountSettings returns the settings declared in the configuration by group and key
+func declaredAccountSettings(d getter) map[string]map[string]bool {
+	declared := make(map[string]map[string]bool)
+
+	c, hasConfig := d.(interface{ GetRawConfig() cty.Value })
+
+	for _, group := range accountSettingsGroups {
+		if hasConfig {
+			config := c.GetRawConfig()
+			if config.IsNull() {
+				continue
+			}
+			blocks := config.GetAttr(group.name)
+			if blocks.IsNull() || blocks.LengthInt() == 0 {
+				continue
+			}
+			block := blocks.Index(cty.NumberIntVal(0))
+			for _, setting := range group.settings {
+				v := block.GetAttr(setting.key)
+				if v.IsNull() || !v.IsKnown() {
+					continue
+				}
+				if declared[group.name] == nil {
+					declared[group.name] = make(map[string]bool)
+				}
+				declared[group.name][setting.key] = v.True()
+			}
+			continue
+		}
+
+		// without configuration, the settings of the block are the declared ones
+		blocks, _ := d.Get(group.name).([]interface{})
+		if len(blocks) == 0 {
+			continue
+		}
+		block, _ := blocks[0].(map[string]interface{})
+		for k, v := range block {
+			if declared[group.name] == nil {
+				declared[group.name] = make(map[string]bool)
+			}
+			declared[group.name][k] = v.(bool)
+		}
+	}
+
+	return declared
+}
+
+// isAccountSettingDeclared reports whether the setting, by `<group>.<setting>`, is declared
+func isAccountSettingDeclared(declared map[string]map[string]bool, key string) bool {
+	parts := strings.SplitN(key, ".", 2)
+	if len(parts) != 2 {
+		return false
+	}
+	_, ok := declared[parts[0]][parts[1]]
+	return ok
+}
+
+// hasUndeclaredAccountSettings reports whether prior values are recorded for settings which are no longer declared
+func hasUndeclaredAccountSettings(d getter) bool {
+	declared := declaredAccountSettings(d)
+
+	prior, _ := d.Get("prior_values").(map[string]interface{})
+	for k := range prior {
+		if !isAccountSettingDeclared(declared, k) {
+			return true
+		}
+	}
+	return false
+}
+
+// getAccountSettings returns the settings of the managed groups by group and key
+func getAccountSettings(ctx context.Context, zd client.BaseAPI) (map[string]map[string]interface{}, error) {
+	var result struct {
+		Settings map[string]interface{} `json:"settings"`
+	}
+	err := getJSON(ctx, zd, "/account/settings.json", &result)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := make(map[string]map[string]interface{})
+	for _, group := range accountSettingsGroups {
+		if v, ok := result.Settings[group.name].(map[string]interface{}); ok {
+			settings[group.name] = v
+		}
+	}
+	return settings, nil
+}
+
+func putAccountSettings(ctx context.Context, zd client.BaseAPI, settings map[string]map[string]interface{}) error {
+	if len(settings) == 0 {
+		return nil
+	}
+
+	data := map[string]interface{}{
+		"settings": settings,
+	}
+	return putJSON(ctx, zd, "/account/settings.json", data, nil)
+}
+
+// priorAccountSettings parses the prior values into the settings to restore
+func priorAccountSettings(prior map[string]interface{}) (map[string]map[string]interface{}, error) {
+	settings := make(map[string]map[string]interface{})
+	for k, v := range prior {
+		parts := strings.SplitN(k, ".", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("could not parse prior account setting %s", k)
+		}
+
+		value, err := strconv.ParseBool(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("could not parse the prior value of account setting %s: %v", k, err)
+		}
+
+		if settings[parts[0]] == nil {
+			settings[parts[0]] = make(map[string]interface{})
+		}
+		settings[parts[0]][parts[1]] = value
+	}
+	return settings, nil
+}
+
+// setAccountSettings applies the declared settings, restoring the prior values of settings no longer declared
+func setAccountSettings(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	declared := declaredAccountSettings(d)
+
+	current, err := getAccountSettings(ctx, zd)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	prior := make(map[string]interface{})
+	if v, ok := d.Get("prior_values").(map[string]interface{}); ok {
+		for k, value := range v {
+			prior[k] = value
+		}
+	}
+
+	restored := make(map[string]interface{})
+	for k, v := range prior {
+		if isAccountSettingDeclared(declared, k) {
+			continue
+		}
+		restored[k] = v
+		delete(prior, k)
+	}
+
+	update, err := priorAccountSettings(restored)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for group, settings := range declared {
+		for k, v := range settings {
+			key := group + "." + k
+			if _, ok := prior[key]; !ok {
+				currentValue, ok := current[group][k].(bool)
+				if !ok {
+					return diag.Errorf("the account has no setting %s", key)
+				}
+				prior[key] = strconv.FormatBool(currentValue)
+			}
+
+			if update[group] == nil {
+				update[group] = make(map[string]interface{})
+			}
+			update[group][k] = v
+		}
+	}
+
+	err = putAccountSettings(ctx, zd, update)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(accountSettingsID)
+
+	err = d.Set("prior_values", prior)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return readAccountSettings(ctx, d, zd)
+}
+
+func readAccountSettings(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	current, err := getAccountSettings(ctx, zd)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	fields := make(map[string]interface{})
+	for _, group := range accountSettingsGroups {
+		// only the declared groups are kept in state
+		if blocks, _ := d.Get(group.name).([]interface{}); len(blocks) == 0 {
+			continue
+		}
+
+		settings := make(map[string]interface{})
+		for _, setting := range group.settings {
+			if v, ok := current[group.name][setting.key].(bool); ok {
+				settings[setting.key] = v
+			}
+		}
+		fields[group.name] = []interface{}{settings}
+	}
+
+	err = setSchemaFields(d, fields)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func deleteAccountSettings(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	prior, _ := d.Get("prior_values").(map[string]interface{})
+	settings, err := priorAccountSettings(prior)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = putAccountSettings(ctx, zd, settings)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
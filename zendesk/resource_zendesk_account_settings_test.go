@@ -0,0 +1,252 @@
+package zendesk
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+const accountSettingsJSON = `{"settings": {
+	"tickets": {"agent_collision": false, "tagging": true, "comments_public_by_default": true},
+	"agents": {"focus_mode": false, "agent_workspace": true},
+	"side_conversations": {"email_channel": false},
+	"brands": {"default_brand_id": 1},
+	"lotus": {"prefer_lotus": true}
+}}`
+
+// accountSettingsConfig builds the configuration of zendesk_account_settings declaring the settings
+func accountSettingsConfig(declared map[string]map[string]bool) cty.Value {
+	attrs := make(map[string]cty.Value)
+	for _, group := range accountSettingsGroups {
+		blockType := make(map[string]cty.Type)
+		for _, setting := range group.settings {
+			blockType[setting.key] = cty.Bool
+		}
+
+		if declared[group.name] == nil {
+			attrs[group.name] = cty.ListValEmpty(cty.Object(blockType))
+			continue
+		}
+
+		block := make(map[string]cty.Value)
+		for _, setting := range group.settings {
+			block[setting.key] = cty.NullVal(cty.Bool)
+			if v, ok := declared[group.name][setting.key]; ok {
+				block[setting.key] = cty.BoolVal(v)
+			}
+		}
+		attrs[group.name] = cty.ListVal([]cty.Value{cty.ObjectVal(block)})
+	}
+	attrs["prior_values"] = cty.NullVal(cty.Map(cty.String))
+
+	return cty.ObjectVal(attrs)
+}
+
+func TestDeclaredAccountSettingsFromConfig(t *testing.T) {
+	d := rawConfigGetterSetter{
+		changeGetterSetter: changeGetterSetter{
+			identifiableMapGetterSetter: &identifiableMapGetterSetter{
+				mapGetterSetter: mapGetterSetter{
+					// computed settings of the block are in the plan too
+					"tickets": []interface{}{map[string]interface{}{"agent_collision": true, "tagging": true}},
+				},
+			},
+		},
+		config: accountSettingsConfig(map[string]map[string]bool{
+			"tickets": {"agent_collision": true},
+		}),
+	}
+
+	declared := declaredAccountSettings(d)
+	expected := map[string]map[string]bool{
+		"tickets": {"agent_collision": true},
+	}
+	if !reflect.DeepEqual(declared, expected) {
+		t.Fatalf("declaredAccountSettings returned %v. should have been %v", declared, expected)
+	}
+}
+
+func TestHasUndeclaredAccountSettings(t *testing.T) {
+	prior := map[string]interface{}{
+		"tickets.agent_collision": "false",
+		"tickets.tagging":         "true",
+	}
+
+	cases := []struct {
+		name       string
+		declared   map[string]map[string]bool
+		undeclared bool
+	}{
+		{"all settings declared", map[string]map[string]bool{"tickets": {"agent_collision": true, "tagging": false}}, false},
+		{"declared setting removed", map[string]map[string]bool{"tickets": {"agent_collision": true}}, true},
+		{"group removed", map[string]map[string]bool{}, true},
+	}
+
+	for _, c := range cases {
+		d := rawConfigGetterSetter{
+			changeGetterSetter: changeGetterSetter{
+				identifiableMapGetterSetter: &identifiableMapGetterSetter{
+					id: accountSettingsID,
+					mapGetterSetter: mapGetterSetter{
+						// the removed settings are still in the plan, as they are computed
+						"tickets":      []interface{}{map[string]interface{}{"agent_collision": true, "tagging": false}},
+						"prior_values": prior,
+					},
+				},
+			},
+			config: accountSettingsConfig(c.declared),
+		}
+
+		if v := hasUndeclaredAccountSettings(d); v != c.undeclared {
+			t.Fatalf("%s: hasUndeclaredAccountSettings returned %v. should have been %v", c.name, v, c.undeclared)
+		}
+	}
+}
+
+func TestSetAccountSettingsRecordsPriorValues(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"tickets": []interface{}{map[string]interface{}{"agent_collision": true}},
+		},
+	}
+
+	gomock.InOrder(
+		m.EXPECT().Get(gomock.Any(), gomock.Eq("/account/settings.json")).Return([]byte(accountSettingsJSON), nil),
+		m.EXPECT().Put(gomock.Any(), gomock.Eq("/account/settings.json"), gomock.Eq(map[string]interface{}{
+			"settings": map[string]map[string]interface{}{
+				"tickets": {"agent_collision": true},
+			},
+		})).Return([]byte(`{}`), nil),
+		m.EXPECT().Get(gomock.Any(), gomock.Eq("/account/settings.json")).
+			Return([]byte(`{"settings": {"tickets": {"agent_collision": true, "tagging": true}, "agents": {"focus_mode": false}}}`), nil),
+	)
+
+	if diags := setAccountSettings(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("setAccountSettings returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "account" {
+		t.Fatalf("setAccountSettings did not set resource id. Id was %s", v)
+	}
+
+	if v := i.Get("prior_values"); !reflect.DeepEqual(v, map[string]interface{}{"tickets.agent_collision": "false"}) {
+		t.Fatalf("setAccountSettings set prior_values %v", v)
+	}
+
+	// undeclared groups are not read into the state
+	if v := i.Get("agents"); v != nil {
+		t.Fatalf("setAccountSettings read the undeclared agents settings %v", v)
+	}
+
+	tickets := i.Get("tickets").([]interface{})[0].(map[string]interface{})
+	if tickets["tagging"] != true {
+		t.Fatalf("setAccountSettings did not read the other settings of the declared group. tickets were %v", tickets)
+	}
+}
+
+func TestSetAccountSettingsRestoresUndeclaredSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "account",
+		mapGetterSetter: mapGetterSetter{
+			"tickets": []interface{}{map[string]interface{}{"agent_collision": true}},
+			"prior_values": map[string]interface{}{
+				"tickets.agent_collision": "false",
+				"agents.focus_mode":       "true",
+			},
+		},
+	}
+
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/account/settings.json")).Return([]byte(accountSettingsJSON), nil).Times(2)
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/account/settings.json"), gomock.Eq(map[string]interface{}{
+		"settings": map[string]map[string]interface{}{
+			"agents":  {"focus_mode": true},
+			"tickets": {"agent_collision": true},
+		},
+	})).Return([]byte(`{}`), nil)
+
+	if diags := setAccountSettings(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("setAccountSettings returned an error: %v", diags)
+	}
+
+	if v := i.Get("prior_values"); !reflect.DeepEqual(v, map[string]interface{}{"tickets.agent_collision": "false"}) {
+		t.Fatalf("setAccountSettings kept prior_values %v", v)
+	}
+}
+
+func TestSetAccountSettingsUnknownSetting(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"side_conversations": []interface{}{map[string]interface{}{"slack_channel": true}},
+		},
+	}
+
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/account/settings.json")).Return([]byte(accountSettingsJSON), nil)
+
+	if diags := setAccountSettings(context.Background(), i, m); len(diags) != 1 {
+		t.Fatalf("setAccountSettings did not return an error for a setting missing from the account: %v", diags)
+	}
+}
+
+func TestDeleteAccountSettingsRestoresPriorValues(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "account",
+		mapGetterSetter: mapGetterSetter{
+			"prior_values": map[string]interface{}{
+				"tickets.agent_collision":          "false",
+				"side_conversations.email_channel": "true",
+			},
+		},
+	}
+
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/account/settings.json"), gomock.Eq(map[string]interface{}{
+		"settings": map[string]map[string]interface{}{
+			"side_conversations": {"email_channel": true},
+			"tickets":            {"agent_collision": false},
+		},
+	})).Return([]byte(`{}`), nil)
+
+	if diags := deleteAccountSettings(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteAccountSettings returned an error: %v", diags)
+	}
+}
+
+func TestAccAccountSettingsExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: readExampleConfig(t, "resources/zendesk_account_settings/resource.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zendesk_account_settings.account", "tickets.0.agent_collision", "true"),
+					resource.TestCheckResourceAttr("zendesk_account_settings.account", "side_conversations.0.tickets_channel", "true"),
+					resource.TestCheckNoResourceAttr("zendesk_account_settings.account", "agents.#"),
+					resource.TestCheckResourceAttrSet("zendesk_account_settings.account", "prior_values.tickets.agent_collision"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,165 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#set-agent-attribute-values
+func resourceZendeskAgentSkills() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the skills of an agent, i.e. the routing attribute values assigned to them. " +
+			"The assignment is authoritative: values that are not listed are removed from the agent. Import with the id of the agent.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return createAgentSkills(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return readAgentSkills(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return updateAgentSkills(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return deleteAgentSkills(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+				id, err := atoi64(d.Id())
+				if err != nil {
+					return nil, fmt.Errorf("could not parse agent id %s: %v", d.Id(), err)
+				}
+				return []*schema.ResourceData{d}, d.Set("agent_id", id)
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"agent_id": {
+				Description: "The id of the agent.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"attribute_value_ids": {
+				Description: "The ids of the routing attribute values assigned to the agent, i.e. the `value_id` of `zendesk_routing_attribute_value` resources.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Required: true,
+			},
+		},
+	}
+}
+
+func agentSkillsPath(agentID int64) string {
+	return fmt.Sprintf("/routing/agents/%d/instance_values.json", agentID)
+}
+
+// setAgentSkills replaces the attribute values of the agent
+func setAgentSkills(ctx context.Context, zd client.BaseAPI, agentID int64, valueIDs []string) error {
+	data := map[string]interface{}{
+		"attribute_value_ids": valueIDs,
+	}
+	return postJSON(ctx, zd, agentSkillsPath(agentID), data, nil)
+}
+
+func unmarshalAgentSkillValueIDs(d getter) []string {
+	ids := make([]string, 0)
+	if v, ok := d.GetOk("attribute_value_ids"); ok {
+		for _, id := range v.(*schema.Set).List() {
+			ids = append(ids, id.(string))
+		}
+	}
+
+	sort.Strings(ids)
+	return ids
+}
+
+func createAgentSkills(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	agentID := int64(d.Get("agent_id").(int))
+
+	err := setAgentSkills(ctx, zd, agentID, unmarshalAgentSkillValueIDs(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", agentID))
+
+	return readAgentSkills(ctx, d, zd)
+}
+
+func readAgentSkills(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	agentID, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result struct {
+		AttributeValues []routingAttributeValue `json:"attribute_values"`
+	}
+	err = getJSON(ctx, zd, agentSkillsPath(agentID), &result)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := make([]string, 0, len(result.AttributeValues))
+	for _, value := range result.AttributeValues {
+		ids = append(ids, value.ID)
+	}
+
+	err = setSchemaFields(d, map[string]interface{}{
+		"agent_id":            agentID,
+		"attribute_value_ids": ids,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateAgentSkills(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	agentID, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = setAgentSkills(ctx, zd, agentID, unmarshalAgentSkillValueIDs(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return readAgentSkills(ctx, d, zd)
+}
+
+// deleteAgentSkills removes every attribute value from the agent
+func deleteAgentSkills(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	agentID, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = setAgentSkills(ctx, zd, agentID, []string{})
+	if err != nil && !isNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,102 @@
+package zendesk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+// There is no acceptance test, as the example needs the id of an existing agent.
+
+func TestCreateAgentSkills(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"agent_id":            1234,
+			"attribute_value_ids": schema.NewSet(schema.HashString, []interface{}{"b376b35a", "6e279587"}),
+		},
+	}
+
+	m.EXPECT().Post(gomock.Any(), gomock.Eq("/routing/agents/1234/instance_values.json"), gomock.Eq(map[string]interface{}{
+		"attribute_value_ids": []string{"6e279587", "b376b35a"},
+	})).Return([]byte(`{"attribute_values": []}`), nil)
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/routing/agents/1234/instance_values.json")).
+		Return([]byte(`{"attribute_values": [{"id": "6e279587", "attribute_id": "15821cba", "name": "English"}, {"id": "b376b35a", "attribute_id": "f2b6ebe4", "name": "T-800"}]}`), nil)
+
+	if diags := createAgentSkills(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createAgentSkills returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "1234" {
+		t.Fatalf("createAgentSkills did not set resource id. Id was %s", v)
+	}
+
+	if v := i.Get("attribute_value_ids").([]string); len(v) != 2 {
+		t.Fatalf("createAgentSkills set attribute_value_ids %v", v)
+	}
+}
+
+func TestReadAgentSkillsNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("1234")
+
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/routing/agents/1234/instance_values.json")).Return(nil, notFoundError())
+	if diags := readAgentSkills(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readAgentSkills returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "" {
+		t.Fatalf("readAgentSkills did not remove the skills of the deleted agent. Id was %s", v)
+	}
+}
+
+func TestUpdateAgentSkillsRemovesAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "1234",
+		mapGetterSetter: mapGetterSetter{
+			"agent_id":            1234,
+			"attribute_value_ids": schema.NewSet(schema.HashString, []interface{}{}),
+		},
+	}
+
+	m.EXPECT().Post(gomock.Any(), gomock.Eq("/routing/agents/1234/instance_values.json"), gomock.Eq(map[string]interface{}{
+		"attribute_value_ids": []string{},
+	})).Return([]byte(`{"attribute_values": []}`), nil)
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/routing/agents/1234/instance_values.json")).
+		Return([]byte(`{"attribute_values": []}`), nil)
+
+	if diags := updateAgentSkills(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateAgentSkills returned an error: %v", diags)
+	}
+}
+
+func TestDeleteAgentSkills(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("1234")
+
+	m.EXPECT().Post(gomock.Any(), gomock.Eq("/routing/agents/1234/instance_values.json"), gomock.Eq(map[string]interface{}{
+		"attribute_value_ids": []string{},
+	})).Return([]byte(`{"attribute_values": []}`), nil)
+
+	if diags := deleteAgentSkills(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteAgentSkills returned an error: %v", diags)
+	}
+}
@@ -1,16 +1,27 @@
 package zendesk
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"io"
+	"io/fs"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/nukosuke/go-zendesk/zendesk"
 )
 
+// Attributes an attachment can be uploaded from. Exactly one of them is set.
+var attachmentContentSources = []string{"file_path", "content", "content_base64"}
+
 type attachment struct {
 	zendesk.Attachment
 	FilePath string
@@ -34,16 +45,34 @@ func resourceZendeskAttachment() *schema.Resource {
 		},
 		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 			zd := i.(zendesk.AttachmentAPI)
+			if data.HasChange("file_hash") {
+				return updateAttachment(ctx, data, zd)
+			}
 			return readAttachment(ctx, data, zd)
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customizeAttachmentDiff,
 		Schema: map[string]*schema.Schema{
 			"file_path": {
+				Description:  "Path to the file to upload. The file is read at apply time, so it may be generated by the same run.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: attachmentContentSources,
+			},
+			"content": {
+				Description:  "Content of the file to upload, as a UTF-8 string.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: attachmentContentSources,
+			},
+			"content_base64": {
+				Description:  "Content of the file to upload, base64 encoded. Use it for binary files, e.g. with `filebase64()`.",
 				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: isValidFile(),
+				Optional:     true,
+				ValidateFunc: validation.StringIsBase64,
+				ExactlyOneOf: attachmentContentSources,
 			},
 			"file_name": {
 				Description: "The name of the image file.",
@@ -52,13 +81,14 @@ func resourceZendeskAttachment() *schema.Resource {
 				ForceNew:    true,
 			},
 			"file_hash": {
-				Description: "SHA256 hash of the image file. Terraform built-in `filesha256()` is convenient to calculate it.",
+				Description: "SHA256 hash of the uploaded content, computed by the provider. The content is uploaded again when it changes.",
 				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Optional:    true,
+				Computed:    true,
+				Deprecated:  "file_hash is computed by the provider from the uploaded content. Remove it from the configuration.",
 			},
 			"token": {
-				Description: "The token of the uploaded attachment.",
+				Description: "The token of the uploaded attachment. Zendesk deletes uploads that are not attached to anything within a few days, in which case the content is uploaded again.",
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
@@ -68,9 +98,11 @@ func resourceZendeskAttachment() *schema.Resource {
 				Computed:    true,
 			},
 			"content_type": {
-				Description: `The content type of the image. Example value: "image/png"`,
+				Description: `The content type of the image. Example value: "image/png". Zendesk detects it from the file name unless it is set.`,
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
+				ForceNew:    true,
 			},
 			"size": {
 				Description: "The size of the image file in bytes.",
@@ -123,17 +155,22 @@ func resourceZendeskAttachment() *schema.Resource {
 func createAttachment(ctx context.Context, d identifiableGetterSetter, zd zendesk.AttachmentAPI) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	filePath := d.Get("file_path").(string)
-	file, err := os.Open(filePath)
+	content, err := openAttachmentContent(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	defer file.Close()
+	defer content.Close()
+
+	var contentType string
+	if v, ok := d.GetOk("content_type"); ok {
+		contentType = v.(string)
+	}
 
 	fileName := d.Get("file_name").(string)
-	w := zd.UploadAttachment(ctx, fileName, "")
+	w := zd.UploadAttachment(withUploadContentType(ctx, contentType), fileName, "")
 
-	_, err = io.Copy(w, file)
+	h := sha256.New()
+	_, err = io.Copy(w, io.TeeReader(content, h))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -152,8 +189,16 @@ func createAttachment(ctx context.Context, d identifiableGetterSetter, zd zendes
 
 	out := attachment{
 		Attachment: a,
-		FilePath:   filePath,
-		Hash:       d.Get("file_hash").(string),
+		Hash:       hex.EncodeToString(h.Sum(nil)),
+	}
+
+	if v, ok := d.GetOk("file_path"); ok {
+		out.FilePath = v.(string)
+	}
+
+	// a hash set in the configuration is kept so that the plan stays empty
+	if v, ok := d.GetOk("file_hash"); ok {
+		out.Hash = v.(string)
 	}
 
 	err = marshalAttachment(d, out)
@@ -164,6 +209,23 @@ func createAttachment(ctx context.Context, d identifiableGetterSetter, zd zendes
 	return diags
 }
 
+// updateAttachment uploads the changed content and deletes the previous upload
+func updateAttachment(ctx context.Context, d identifiableGetterSetter, zd zendesk.AttachmentAPI) diag.Diagnostics {
+	previous, _ := d.Get("token").(string)
+
+	diags := createAttachment(ctx, d, zd)
+	if diags.HasError() || previous == "" {
+		return diags
+	}
+
+	err := zd.DeleteUpload(ctx, previous)
+	if err != nil && !isNotFound(err) {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
 func deleteAttachment(ctx context.Context, d identifiableGetterSetter, zd zendesk.AttachmentAPI) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -173,8 +235,9 @@ func deleteAttachment(ctx context.Context, d identifiableGetterSetter, zd zendes
 		return nil
 	}
 
+	// the token expired and the upload is already gone
 	err := zd.DeleteUpload(ctx, v.(string))
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		return diag.FromErr(err)
 	}
 
@@ -200,6 +263,11 @@ func readAttachment(ctx context.Context, d identifiableGetterSetter, zd zendesk.
 	}
 
 	a, err := zd.GetAttachment(ctx, id)
+	if isNotFound(err) {
+		// the upload expired. removing it from the state plans a new upload
+		d.SetId("")
+		return diags
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -240,3 +308,70 @@ func marshalAttachment(d identifiableGetterSetter, a attachment) error {
 	m["thumbnails"] = thumbnails
 	return setSchemaFields(d, m)
 }
+
+// customizeAttachmentDiff computes the hash of the content to upload, which
+// uploads the content again when it changes. The content of a file that does
+// not exist yet is unknown until apply.
+func customizeAttachmentDiff(ctx context.Context, d *schema.ResourceDiff, i interface{}) error {
+	if config := d.GetRawConfig(); !config.IsNull() && !config.GetAttr("file_hash").IsNull() {
+		return nil
+	}
+
+	for _, k := range attachmentContentSources {
+		if !d.NewValueKnown(k) {
+			return d.SetNewComputed("file_hash")
+		}
+	}
+
+	hash, err := attachmentContentHash(d)
+	if errors.Is(err, fs.ErrNotExist) {
+		return d.SetNewComputed("file_hash")
+	}
+	if err != nil {
+		return err
+	}
+
+	if d.Get("file_hash").(string) == hash {
+		return nil
+	}
+
+	return d.SetNew("file_hash", hash)
+}
+
+// openAttachmentContent opens the content to upload from whichever source is set
+func openAttachmentContent(d getter) (io.ReadCloser, error) {
+	if v, ok := d.GetOk("content"); ok {
+		return io.NopCloser(strings.NewReader(v.(string))), nil
+	}
+
+	if v, ok := d.GetOk("content_base64"); ok {
+		b, err := base64.StdEncoding.DecodeString(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		return io.NopCloser(bytes.NewReader(b)), nil
+	}
+
+	if v, ok := d.GetOk("file_path"); ok {
+		return os.Open(v.(string))
+	}
+
+	return nil, errors.New("one of file_path, content or content_base64 must be set")
+}
+
+// attachmentContentHash returns the hex encoded SHA256 hash of the content to upload
+func attachmentContentHash(d getter) (string, error) {
+	content, err := openAttachmentContent(d)
+	if err != nil {
+		return "", err
+	}
+	defer content.Close()
+
+	h := sha256.New()
+	_, err = io.Copy(h, content)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -1,9 +1,9 @@
 package zendesk
 
 import (
+	"bytes"
 	"context"
-	"crypto/sha1"
-	"encoding/hex"
+	"encoding/base64"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"io"
@@ -22,7 +22,13 @@ const attachmentConfig = `
 resource "zendesk_attachment" "file" {
   file_name = "street.jpg"
   file_path = "%s"
-  file_hash = "%s"
+}
+`
+
+const attachmentContentConfig = `
+resource "zendesk_attachment" "notes" {
+  file_name = "notes.txt"
+  content   = "%s"
 }
 `
 
@@ -81,6 +87,154 @@ func TestCreateZendeskAttachment(t *testing.T) {
 	}
 }
 
+func TestCreateZendeskAttachmentFromContent(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	u := zendesk.Upload{
+		Attachment: zendesk.Attachment{
+			ID:          1234,
+			FileName:    "hello.txt",
+			ContentType: "text/plain",
+		},
+	}
+
+	var uploaded bytes.Buffer
+	m.EXPECT().UploadAttachment(Any(), Eq("hello.txt"), Any()).Return(mockUploadWriter{Writer: &uploaded, Response: u})
+
+	d := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"content":   "hello",
+			"file_name": "hello.txt",
+		},
+	}
+
+	diags := createAttachment(context.Background(), d, m)
+	if len(diags) != 0 {
+		t.Fatalf("Create attachment returned an error %v", diags)
+	}
+
+	if v := uploaded.String(); v != "hello" {
+		t.Fatalf("uploaded content was %s. should have been hello", v)
+	}
+
+	// sha256 of "hello"
+	expected := "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	if v := d.Get("file_hash"); v != expected {
+		t.Fatalf("file_hash was %s. should have been %s", v, expected)
+	}
+}
+
+func TestAttachmentContentHash(t *testing.T) {
+	// sha256 of testdata/street.jpg, same as filesha256()
+	expected := "56da6dc345c22fbf92850f06dfff50d9db18bb78a87ce93b2775aa4f0ce78a78"
+
+	b, err := os.ReadFile("testdata/street.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []mapGetterSetter{
+		{"file_path": "testdata/street.jpg"},
+		{"content_base64": base64.StdEncoding.EncodeToString(b)},
+		{"content": string(b)},
+	}
+
+	for _, c := range cases {
+		hash, err := attachmentContentHash(c)
+		if err != nil {
+			t.Fatalf("attachmentContentHash returned an error for %v: %v", c, err)
+		}
+
+		if hash != expected {
+			t.Fatalf("attachmentContentHash returned %s. should have been %s", hash, expected)
+		}
+	}
+}
+
+func notFoundError() error {
+	return zendesk.NewError([]byte(`{"error":"RecordNotFound"}`), &http.Response{StatusCode: http.StatusNotFound})
+}
+
+func TestReadZendeskAttachmentRemovesExpiredUpload(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	m.EXPECT().GetAttachment(Any(), Eq(int64(1234))).Return(zendesk.Attachment{}, notFoundError())
+
+	d := newIdentifiableGetterSetter()
+	d.SetId("1234")
+
+	diags := readAttachment(context.Background(), d, m)
+	if len(diags) != 0 {
+		t.Fatalf("read attachment returned an error %v", diags)
+	}
+
+	if v := d.Id(); v != "" {
+		t.Fatalf("expired attachment should have been removed from the state. id was %s", v)
+	}
+}
+
+func TestUpdateZendeskAttachmentUploadsAgain(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	u := zendesk.Upload{
+		Attachment: zendesk.Attachment{
+			ID:       5678,
+			FileName: "hello.txt",
+		},
+		Token: "new-token",
+	}
+
+	m.EXPECT().UploadAttachment(Any(), Eq("hello.txt"), Any()).Return(newMockUploadWriter(u, nil))
+	m.EXPECT().DeleteUpload(Any(), Eq("old-token")).Return(notFoundError())
+
+	d := &identifiableMapGetterSetter{
+		id: "1234",
+		mapGetterSetter: mapGetterSetter{
+			"content":   "hello again",
+			"file_name": "hello.txt",
+			"token":     "old-token",
+		},
+	}
+
+	diags := updateAttachment(context.Background(), d, m)
+	if len(diags) != 0 {
+		t.Fatalf("update attachment returned an error %v", diags)
+	}
+
+	if v := d.Id(); v != "5678" {
+		t.Fatalf("update attachment did not set the id of the new upload. id was %s", v)
+	}
+
+	if v := d.Get("token"); v != "new-token" {
+		t.Fatalf("update attachment did not set the token of the new upload. token was %s", v)
+	}
+}
+
+func TestDeleteZendeskAttachmentIgnoresExpiredToken(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	m.EXPECT().DeleteUpload(Any(), Eq("foo")).Return(notFoundError())
+
+	d := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"token": "foo",
+		},
+	}
+
+	diags := deleteAttachment(context.Background(), d, m)
+	if len(diags) != 0 {
+		t.Fatalf("delete attachment returned an error %v", diags)
+	}
+}
+
 func TestDeleteZendeskAttachmentCallsWhenTokenIsSet(t *testing.T) {
 	ctrl := NewController(t)
 	defer ctrl.Finish()
@@ -154,9 +308,6 @@ func TestAccZendeskAttachment(t *testing.T) {
 	}
 	defer original.Close()
 
-	h := sha1.New()
-	tee := io.TeeReader(original, h)
-
 	tmpfile, err := ioutil.TempFile("", "new-streets.jpg")
 	if err != nil {
 		t.Fatalf("could not create temp file")
@@ -165,13 +316,11 @@ func TestAccZendeskAttachment(t *testing.T) {
 	defer os.Remove(tmpfile.Name())
 	defer tmpfile.Close()
 
-	_, err = io.Copy(tmpfile, tee)
+	_, err = io.Copy(tmpfile, original)
 	if err != nil {
 		t.Fatalf("error creating temp file: %v", err)
 	}
 
-	hashString := hex.EncodeToString(h.Sum(nil))
-
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
@@ -180,20 +329,53 @@ func TestAccZendeskAttachment(t *testing.T) {
 		CheckDestroy: testAttachmentDestroyed,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(attachmentConfig, original.Name(), hashString),
+				Config: fmt.Sprintf(attachmentConfig, original.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("zendesk_attachment.file", "content_url"),
 					resource.TestCheckResourceAttr("zendesk_attachment.file", "file_path", original.Name()),
 				),
 			},
 			{
-				Config: fmt.Sprintf(attachmentConfig, tmpfile.Name(), hashString),
+				Config: fmt.Sprintf(attachmentConfig, tmpfile.Name()),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("zendesk_attachment.file", "content_url"),
 					resource.TestCheckResourceAttr("zendesk_attachment.file", "file_path", tmpfile.Name()),
 				),
 			},
+			{
+				Config: readExampleConfig(t, "resources/zendesk_attachment/resource.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("zendesk_attachment.logo", "file_hash", "zendesk_attachment.logo-inline", "file_hash"),
+					resource.TestCheckResourceAttr("zendesk_attachment.logo-inline", "content_type", "image/jpeg"),
+					resource.TestCheckResourceAttr("zendesk_attachment.notes", "content_type", "text/plain"),
+				),
+			},
 		},
 	})
 
 }
+
+func TestAccZendeskAttachmentContentChange(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAttachmentDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(attachmentContentConfig, "hello"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zendesk_attachment.notes", "file_hash", "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(attachmentContentConfig, "hello again"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("zendesk_attachment.notes", "token"),
+					resource.TestCheckResourceAttr("zendesk_attachment.notes", "size", "11"),
+				),
+			},
+		},
+	})
+}
@@ -11,29 +11,64 @@ import (
 	client "github.com/nukosuke/go-zendesk/zendesk"
 )
 
+// automation is the automation payload including fields not yet supported by the client
+type automation struct {
+	client.Automation
+	Description string `json:"description"`
+}
+
+// Automations must contain a condition that checks the time elapsed since a ticket event
+// ref: https://support.zendesk.com/hc/en-us/articles/4408832701850
+var automationTimeBasedConditionFields = []string{
+	"NEW",
+	"OPEN",
+	"PENDING",
+	"HOLD",
+	"SOLVED",
+	"CLOSED",
+	"assigned_at",
+	"updated_at",
+	"requester_updated_at",
+	"assignee_updated_at",
+	"due_date",
+	"until_due_date",
+	"last_sla_breach",
+	"next_sla_breach",
+}
+
 // https://developer.zendesk.com/api-reference/ticketing/business-rules/automations/
 func resourceZendeskAutomation() *schema.Resource {
 	return &schema.Resource{
 		Description: "Provides an automation resource.",
 		CreateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
-			zd := i.(client.AutomationAPI)
+			zd := i.(client.BaseAPI)
 			return createAutomation(ctx, d, zd)
 		},
 		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
-			zd := i.(client.AutomationAPI)
+			zd := i.(client.BaseAPI)
 			return readAutomation(ctx, d, zd)
 		},
 		UpdateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
-			zd := i.(client.AutomationAPI)
+			zd := i.(client.BaseAPI)
 			return updateAutomation(ctx, d, zd)
 		},
 		DeleteContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
-			zd := i.(client.AutomationAPI)
+			zd := i.(client.BaseAPI)
 			return deleteAutomation(ctx, d, zd)
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, i interface{}) error {
+			// conditions and actions may refer to values only known after apply
+			for _, k := range []string{"all", "any", "action"} {
+				if !d.NewValueKnown(k) {
+					return nil
+				}
+			}
+
+			return validateAutomationConditions(d)
+		},
 
 		Schema: map[string]*schema.Schema{
 			"title": {
@@ -41,6 +76,12 @@ func resourceZendeskAutomation() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"description": {
+				Description: "The description of the automation.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
 			"active": {
 				Description: "Whether the automation is active.",
 				Type:        schema.TypeBool,
@@ -80,11 +121,12 @@ func resourceZendeskAutomation() *schema.Resource {
 }
 
 // Marshal the zendesk client object to the terraform schema
-func marshalAutomation(automation client.Automation, d identifiableGetterSetter) error {
+func marshalAutomation(automation automation, d identifiableGetterSetter) error {
 	fields := map[string]interface{}{
-		"title":    automation.Title,
-		"active":   automation.Active,
-		"position": automation.Position,
+		"title":       automation.Title,
+		"description": automation.Description,
+		"active":      automation.Active,
+		"position":    automation.Position,
 	}
 
 	var alls []map[string]interface{}
@@ -137,8 +179,8 @@ func marshalAutomation(automation client.Automation, d identifiableGetterSetter)
 }
 
 // Unmarshal the terraform schema to the Zendesk client object
-func unmarshalAutomation(d identifiableGetterSetter) (client.Automation, error) {
-	automation := client.Automation{}
+func unmarshalAutomation(d identifiableGetterSetter) (automation, error) {
+	automation := automation{}
 
 	if v := d.Id(); v != "" {
 		id, err := atoi64(v)
@@ -152,6 +194,9 @@ func unmarshalAutomation(d identifiableGetterSetter) (client.Automation, error)
 		automation.Title = v.(string)
 	}
 
+	// an empty description is sent too, so that it can be cleared
+	automation.Description, _ = d.Get("description").(string)
+
 	if v, ok := d.GetOk("active"); ok {
 		automation.Active = v.(bool)
 	}
@@ -221,7 +266,7 @@ func unmarshalAutomation(d identifiableGetterSetter) (client.Automation, error)
 	return automation, nil
 }
 
-func createAutomation(ctx context.Context, d identifiableGetterSetter, zd client.AutomationAPI) diag.Diagnostics {
+func createAutomation(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	automation, err := unmarshalAutomation(d)
@@ -229,7 +274,7 @@ func createAutomation(ctx context.Context, d identifiableGetterSetter, zd client
 		return diag.FromErr(err)
 	}
 
-	automation, err = zd.CreateAutomation(ctx, automation)
+	automation, err = postAutomation(ctx, zd, "/automations.json", automation)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -244,7 +289,7 @@ func createAutomation(ctx context.Context, d identifiableGetterSetter, zd client
 	return diags
 }
 
-func readAutomation(ctx context.Context, d identifiableGetterSetter, zd client.AutomationAPI) diag.Diagnostics {
+func readAutomation(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	id, err := atoi64(d.Id())
@@ -252,12 +297,15 @@ func readAutomation(ctx context.Context, d identifiableGetterSetter, zd client.A
 		return diag.FromErr(err)
 	}
 
-	automation, err := zd.GetAutomation(ctx, id)
+	var result struct {
+		Automation automation `json:"automation"`
+	}
+	err = getJSON(ctx, zd, fmt.Sprintf("/automations/%d.json", id), &result)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	err = marshalAutomation(automation, d)
+	err = marshalAutomation(result.Automation, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -265,7 +313,7 @@ func readAutomation(ctx context.Context, d identifiableGetterSetter, zd client.A
 	return diags
 }
 
-func updateAutomation(ctx context.Context, d identifiableGetterSetter, zd client.AutomationAPI) diag.Diagnostics {
+func updateAutomation(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	automation, err := unmarshalAutomation(d)
@@ -278,7 +326,7 @@ func updateAutomation(ctx context.Context, d identifiableGetterSetter, zd client
 		return diag.FromErr(err)
 	}
 
-	automation, err = zd.UpdateAutomation(ctx, id, automation)
+	automation, err = putAutomation(ctx, zd, fmt.Sprintf("/automations/%d.json", id), automation)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -291,7 +339,7 @@ func updateAutomation(ctx context.Context, d identifiableGetterSetter, zd client
 	return diags
 }
 
-func deleteAutomation(ctx context.Context, d identifiable, zd client.AutomationAPI) diag.Diagnostics {
+func deleteAutomation(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	id, err := atoi64(d.Id())
@@ -299,7 +347,7 @@ func deleteAutomation(ctx context.Context, d identifiable, zd client.AutomationA
 		return diag.FromErr(err)
 	}
 
-	err = zd.DeleteAutomation(ctx, id)
+	err = zd.Delete(ctx, fmt.Sprintf("/automations/%d.json", id))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -307,6 +355,78 @@ func deleteAutomation(ctx context.Context, d identifiable, zd client.AutomationA
 	return diags
 }
 
+func postAutomation(ctx context.Context, zd client.BaseAPI, path string, in automation) (automation, error) {
+	var data, result struct {
+		Automation automation `json:"automation"`
+	}
+	data.Automation = in
+
+	err := postJSON(ctx, zd, path, data, &result)
+	return result.Automation, err
+}
+
+func putAutomation(ctx context.Context, zd client.BaseAPI, path string, in automation) (automation, error) {
+	var data, result struct {
+		Automation automation `json:"automation"`
+	}
+	data.Automation = in
+
+	err := putJSON(ctx, zd, path, data, &result)
+	return result.Automation, err
+}
+
+// validateAutomationConditions checks the conditions Zendesk requires every automation to have:
+// one that is time-based and one that is nullified by an action, so that it only runs once per ticket.
+func validateAutomationConditions(d getter) error {
+	var conditions []map[string]interface{}
+	for _, k := range []string{"all", "any"} {
+		v, ok := d.GetOk(k)
+		if !ok {
+			continue
+		}
+		for _, c := range v.(*schema.Set).List() {
+			conditions = append(conditions, c.(map[string]interface{}))
+		}
+	}
+
+	actionFields := map[string]bool{}
+	if v, ok := d.GetOk("action"); ok {
+		for _, a := range v.(*schema.Set).List() {
+			field := a.(map[string]interface{})["field"].(string)
+			switch field {
+			// tag actions nullify conditions on the current tags
+			case "set_tags", "add_tags", "remove_tags":
+				field = "current_tags"
+			}
+			actionFields[field] = true
+		}
+	}
+
+	var hasTimeBased, hasNullifying bool
+	for _, c := range conditions {
+		field := c["field"].(string)
+		for _, f := range automationTimeBasedConditionFields {
+			if field == f {
+				hasTimeBased = true
+			}
+		}
+		if actionFields[field] {
+			hasNullifying = true
+		}
+	}
+
+	if !hasTimeBased {
+		return fmt.Errorf("automation must have at least one time-based condition on one of: %s",
+			strings.Join(automationTimeBasedConditionFields, ", "))
+	}
+
+	if !hasNullifying {
+		return fmt.Errorf("automation must have at least one condition that is nullified by an action, e.g. a condition on a field the automation updates")
+	}
+
+	return nil
+}
+
 func automationConditionSchema(desc string) *schema.Schema {
 	return &schema.Schema{
 		Description: desc,
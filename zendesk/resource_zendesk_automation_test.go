@@ -2,22 +2,27 @@ package zendesk
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/nukosuke/go-zendesk/zendesk"
 	"github.com/nukosuke/go-zendesk/zendesk/mock"
 )
 
 func TestMarshalAutomation(t *testing.T) {
-	expected := zendesk.Automation{
-		Title:    "title",
-		Active:   true,
-		Position: 1,
+	expected := automation{
+		Automation: zendesk.Automation{
+			Title:    "title",
+			Active:   true,
+			Position: 1,
+		},
+		Description: "blabla",
 	}
 	m := &identifiableMapGetterSetter{
 		mapGetterSetter: mapGetterSetter{},
@@ -35,6 +40,14 @@ func TestMarshalAutomation(t *testing.T) {
 	if v != expected.Title {
 		t.Fatalf("automation had incorrect title value %v. should have been %v", v, expected.Title)
 	}
+	v, ok = m.GetOk("description")
+	if !ok {
+		t.Fatal("Failed to get description value")
+	}
+	if v != expected.Description {
+		t.Fatalf("automation had incorrect description value %v. should have been %v", v, expected.Description)
+	}
+
 	v, ok = m.GetOk("active")
 	if !ok {
 		t.Fatal("Failed to get active value")
@@ -62,6 +75,122 @@ func TestUnmarshalAutomation(t *testing.T) {
 	if v := m.Get("title"); automation.Title != v {
 		t.Fatalf("automation had title value %v. should have been %v", automation.Title, v)
 	}
+
+	if v := m.Get("description"); automation.Description != v {
+		t.Fatalf("automation had description value %v. should have been %v", automation.Description, v)
+	}
+}
+
+func TestUnmarshalAutomationClearsDescription(t *testing.T) {
+	m := &identifiableMapGetterSetter{
+		id: "100",
+		mapGetterSetter: mapGetterSetter{
+			"title":       "Auto reply",
+			"description": "",
+		},
+	}
+
+	automation, err := unmarshalAutomation(m)
+	if err != nil {
+		t.Fatalf("unmarshal returned an error: %v", err)
+	}
+
+	body, err := json.Marshal(automation)
+	if err != nil {
+		t.Fatalf("could not marshal automation: %v", err)
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		t.Fatalf("could not unmarshal automation: %v", err)
+	}
+
+	if v, ok := payload["description"]; !ok || v != "" {
+		t.Fatalf("automation payload had description %v. should have been sent empty", v)
+	}
+}
+
+func TestValidateAutomationConditions(t *testing.T) {
+	conditionSet := func(conditions ...map[string]interface{}) *schema.Set {
+		s := automationConditionSchema("")
+		set := schema.NewSet(schema.HashResource(s.Elem.(*schema.Resource)), nil)
+		for _, c := range conditions {
+			set.Add(c)
+		}
+		return set
+	}
+	actionSet := func(actions ...map[string]interface{}) *schema.Set {
+		s := resourceZendeskAutomation().Schema["action"]
+		set := schema.NewSet(schema.HashResource(s.Elem.(*schema.Resource)), nil)
+		for _, a := range actions {
+			set.Add(a)
+		}
+		return set
+	}
+
+	solved := map[string]interface{}{"field": "status", "operator": "is", "value": "solved"}
+	hoursSinceSolved := map[string]interface{}{"field": "SOLVED", "operator": "greater_than", "value": "96"}
+	withoutTag := map[string]interface{}{"field": "current_tags", "operator": "not_includes", "value": "reminded"}
+	closeTicket := map[string]interface{}{"field": "status", "value": "closed"}
+	setTag := map[string]interface{}{"field": "set_tags", "value": "reminded"}
+	addTag := map[string]interface{}{"field": "add_tags", "value": "reminded"}
+
+	cases := []struct {
+		name    string
+		d       mapGetterSetter
+		isValid bool
+	}{
+		{
+			name: "time-based and nullifying conditions",
+			d: mapGetterSetter{
+				"all":    conditionSet(solved, hoursSinceSolved),
+				"action": actionSet(closeTicket),
+			},
+			isValid: true,
+		},
+		{
+			name: "tag action nullifies tag condition",
+			d: mapGetterSetter{
+				"all":    conditionSet(hoursSinceSolved, withoutTag),
+				"action": actionSet(setTag),
+			},
+			isValid: true,
+		},
+		{
+			name: "add tags action nullifies tag condition",
+			d: mapGetterSetter{
+				"all":    conditionSet(hoursSinceSolved, withoutTag),
+				"action": actionSet(addTag),
+			},
+			isValid: true,
+		},
+		{
+			name: "missing time-based condition",
+			d: mapGetterSetter{
+				"all":    conditionSet(solved),
+				"action": actionSet(closeTicket),
+			},
+			isValid: false,
+		},
+		{
+			name: "missing nullifying condition",
+			d: mapGetterSetter{
+				"all":    conditionSet(hoursSinceSolved),
+				"action": actionSet(closeTicket),
+			},
+			isValid: false,
+		},
+	}
+
+	for _, c := range cases {
+		err := validateAutomationConditions(c.d)
+		if c.isValid && err != nil {
+			t.Fatalf("%s: expected automation to be valid but got %v", c.name, err)
+		}
+		if !c.isValid && err == nil {
+			t.Fatalf("%s: expected automation to be invalid", c.name)
+		}
+	}
 }
 
 func TestCreateAutomation(t *testing.T) {
@@ -70,12 +199,9 @@ func TestCreateAutomation(t *testing.T) {
 
 	m := mock.NewClient(ctrl)
 	i := newIdentifiableGetterSetter()
-	out := zendesk.Automation{
-		ID:    12345,
-		Title: "automation",
-	}
+	out := []byte(`{"automation": {"id": 12345, "title": "automation"}}`)
 
-	m.EXPECT().CreateAutomation(gomock.Any(), gomock.Any()).Return(out, nil)
+	m.EXPECT().Post(gomock.Any(), gomock.Eq("/automations.json"), gomock.Any()).Return(out, nil)
 	if diags := createAutomation(context.Background(), i, m); len(diags) != 0 {
 		t.Fatal("CreateAutomation return an error")
 	}
@@ -97,14 +223,15 @@ func TestReadAutomation(t *testing.T) {
 	i := newIdentifiableGetterSetter()
 	i.SetId("12345")
 
-	expected := zendesk.Automation{
-		Title:  "automation",
-		Active: true,
-	}
-	m.EXPECT().GetAutomation(gomock.Any(), gomock.Eq(int64(12345))).Return(expected, nil)
+	expected := []byte(`{"automation": {"title": "automation", "description": "blabla", "active": true}}`)
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/automations/12345.json")).Return(expected, nil)
 	if diags := readAutomation(context.Background(), i, m); len(diags) != 0 {
 		t.Fatalf("GetAutomation received an error when calling: %v", diags)
 	}
+
+	if v := i.Get("description"); v != "blabla" {
+		t.Fatalf("readAutomation did not set resource description. description was %s", v)
+	}
 }
 
 func TestUpdateAutomation(t *testing.T) {
@@ -117,7 +244,7 @@ func TestUpdateAutomation(t *testing.T) {
 		mapGetterSetter: mapGetterSetter{},
 	}
 
-	m.EXPECT().UpdateAutomation(gomock.Any(), gomock.Eq(int64(12345)), gomock.Any()).Return(zendesk.Automation{}, nil)
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/automations/12345.json"), gomock.Any()).Return([]byte(`{"automation": {}}`), nil)
 	if diags := updateAutomation(context.Background(), i, m); len(diags) != 0 {
 		t.Fatalf("updateAutomation returned an error %v", diags)
 	}
@@ -132,7 +259,7 @@ func TestDeleteAutomation(t *testing.T) {
 
 	d.SetId("1234")
 
-	c.EXPECT().DeleteAutomation(gomock.Any(), gomock.Eq(int64(1234))).Return(nil)
+	c.EXPECT().Delete(gomock.Any(), gomock.Eq("/automations/1234.json")).Return(nil)
 	diags := deleteAutomation(context.Background(), d, c)
 	if len(diags) != 0 {
 		t.Fatalf("Got error from resource delete: %v", diags)
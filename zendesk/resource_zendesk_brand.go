@@ -3,12 +3,37 @@ package zendesk
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	client "github.com/nukosuke/go-zendesk/zendesk"
 )
 
+// brandAPI is the part of the client used by the brand resource. Logos are
+// uploaded as attachments and host mappings are checked with the base API.
+type brandAPI interface {
+	client.BrandAPI
+	client.AttachmentAPI
+	client.BaseAPI
+}
+
+// brandLogo sets the logo of a brand from an upload token
+type brandLogo struct {
+	Token string `json:"token"`
+}
+
+// hostMappingCheck is the result of the host mapping validity check
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/brands/#check-host-mapping-validity-for-an-existing-brand
+type hostMappingCheck struct {
+	IsValid bool   `json:"is_valid"`
+	Reason  string `json:"reason"`
+	CNAME   string `json:"cname"`
+}
+
 // https://developer.zendesk.com/api-reference/ticketing/account-configuration/brands/
 func resourceZendeskBrand() *schema.Resource {
 	return &schema.Resource{
@@ -23,6 +48,11 @@ func resourceZendeskBrand() *schema.Resource {
 		},
 		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 			zd := meta.(*client.Client)
+			if d.HasChanges("logo_file_path", "logo_file_hash") {
+				if diags := updateBrandLogo(ctx, d, zd); diags.HasError() {
+					return diags
+				}
+			}
 			return updateBrand(ctx, d, zd)
 		},
 		DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -71,9 +101,24 @@ func resourceZendeskBrand() *schema.Resource {
 				Optional:    true,
 			},
 			"logo_attachment_id": {
-				Description: "Logo attachment id for the brand.",
-				Type:        schema.TypeInt,
-				Optional:    true,
+				Description:   "Logo attachment id for the brand.",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"logo_file_path"},
+			},
+			"logo_file_path": {
+				Description:  "Path to an image file to upload as the logo of the brand. Removing it keeps the current logo of the brand.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: isValidFile(),
+				RequiredWith: []string{"logo_file_hash"},
+			},
+			"logo_file_hash": {
+				Description:  "SHA256 hash of the logo file. The logo is uploaded again when it changes. Terraform built-in `filesha256()` is convenient to calculate it.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"logo_file_path"},
 			},
 			"ticket_form_ids": {
 				Description: "The ids of ticket forms that are available for use by a brand.",
@@ -93,10 +138,18 @@ func resourceZendeskBrand() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"host_mapping_ssl_status": {
+				Description: `The result of the host mapping check, not the state of the SSL certificate itself: the host mapping must be valid for Zendesk to provision the certificate. ` +
+					`"valid" when the CNAME record points to Zendesk, otherwise the reason reported by Zendesk, or "check_failed" when the check could not be made, e.g. on plans without host mapping. ` +
+					`Empty when the brand has no host mapping.`,
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"signature_template": {
-				Description: "The signature template for a brand.",
+				Description: "The signature template for a brand. It can also be managed with `zendesk_brand_agent_signature`.",
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 			},
 		},
 	}
@@ -161,7 +214,7 @@ func unmarshalBrand(d identifiableGetterSetter) (client.Brand, error) {
 	}
 
 	if v, ok := d.GetOk("logo_attachment_id"); ok {
-		brand.Logo.ID = v.(int64)
+		brand.Logo.ID = int64(v.(int))
 	}
 
 	if v, ok := d.GetOk("ticket_form_ids"); ok {
@@ -186,7 +239,7 @@ func unmarshalBrand(d identifiableGetterSetter) (client.Brand, error) {
 	return brand, nil
 }
 
-func createBrand(ctx context.Context, d identifiableGetterSetter, zd client.BrandAPI) diag.Diagnostics {
+func createBrand(ctx context.Context, d identifiableGetterSetter, zd brandAPI) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	brand, err := unmarshalBrand(d)
@@ -206,10 +259,14 @@ func createBrand(ctx context.Context, d identifiableGetterSetter, zd client.Bran
 		return diag.FromErr(err)
 	}
 
+	if _, ok := d.GetOk("logo_file_path"); ok {
+		return updateBrandLogo(ctx, d, zd)
+	}
+
 	return diags
 }
 
-func readBrand(ctx context.Context, d identifiableGetterSetter, zd client.BrandAPI) diag.Diagnostics {
+func readBrand(ctx context.Context, d identifiableGetterSetter, zd brandAPI) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	id, err := atoi64(d.Id())
@@ -227,10 +284,35 @@ func readBrand(ctx context.Context, d identifiableGetterSetter, zd client.BrandA
 		return diag.FromErr(err)
 	}
 
+	status := ""
+	if brand.HostMapping != "" {
+		var check hostMappingCheck
+		err = getJSON(ctx, zd, fmt.Sprintf("/brands/%d/check_host_mapping.json", id), &check)
+		switch {
+		case err != nil:
+			// a failed check must not prevent refreshing the brand
+			status = "check_failed"
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("could not check the host mapping %s of brand %d", brand.HostMapping, id),
+				Detail:   err.Error(),
+			})
+		case check.IsValid:
+			status = "valid"
+		default:
+			status = check.Reason
+		}
+	}
+
+	err = d.Set("host_mapping_ssl_status", status)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diags
 }
 
-func updateBrand(ctx context.Context, d identifiableGetterSetter, zd client.BrandAPI) diag.Diagnostics {
+func updateBrand(ctx context.Context, d identifiableGetterSetter, zd brandAPI) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	id, err := atoi64(d.Id())
@@ -256,7 +338,7 @@ func updateBrand(ctx context.Context, d identifiableGetterSetter, zd client.Bran
 	return diags
 }
 
-func deleteBrand(ctx context.Context, d identifiableGetterSetter, zd client.BrandAPI) diag.Diagnostics {
+func deleteBrand(ctx context.Context, d identifiableGetterSetter, zd brandAPI) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	id, err := atoi64(d.Id())
@@ -271,3 +353,59 @@ func deleteBrand(ctx context.Context, d identifiableGetterSetter, zd client.Bran
 
 	return diags
 }
+
+// updateBrandLogo streams the logo file to the uploads API and sets it as the brand logo
+func updateBrandLogo(ctx context.Context, d identifiableGetterSetter, zd brandAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// removing logo_file_path keeps the current logo, only a new file is uploaded
+	filePath, _ := d.Get("logo_file_path").(string)
+	if filePath == "" {
+		return diags
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer file.Close()
+
+	w := zd.UploadAttachment(ctx, filepath.Base(filePath), "")
+
+	_, err = io.Copy(w, file)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	upload, err := w.Close()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var data struct {
+		Brand struct {
+			Logo brandLogo `json:"logo"`
+		} `json:"brand"`
+	}
+	data.Brand.Logo.Token = upload.Token
+
+	var result struct {
+		Brand client.Brand `json:"brand"`
+	}
+	err = putJSON(ctx, zd, fmt.Sprintf("/brands/%d.json", id), data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalBrand(result.Brand, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
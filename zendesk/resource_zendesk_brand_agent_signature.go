@@ -0,0 +1,140 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// The signature template of a brand that has never been customized
+const defaultBrandSignatureTemplate = "{{agent.signature}}"
+
+// https://developer.zendesk.com/api-reference/ticketing/account-configuration/brands/#update-a-brand
+func resourceZendeskBrandAgentSignature() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the agent signature template of a brand, including brands not managed by Terraform such as the default brand. Destroying this resource restores the default signature template.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return createBrandAgentSignature(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return readBrandAgentSignature(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return updateBrandAgentSignature(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return deleteBrandAgentSignature(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+				id, err := atoi64(d.Id())
+				if err != nil {
+					return nil, fmt.Errorf("could not parse brand id %s: %v", d.Id(), err)
+				}
+				return []*schema.ResourceData{d}, d.Set("brand_id", id)
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"brand_id": {
+				Description: "The id of the brand.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"signature_template": {
+				Description: "The signature template appended to comments of agents on tickets of the brand. Placeholders such as `{{agent.signature}}` are supported.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+		},
+	}
+}
+
+func createBrandAgentSignature(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	brandID := int64(d.Get("brand_id").(int))
+
+	err := putBrandSignatureTemplate(ctx, zd, brandID, d.Get("signature_template").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", brandID))
+
+	return readBrandAgentSignature(ctx, d, zd)
+}
+
+func readBrandAgentSignature(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	brandID, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result struct {
+		Brand client.Brand `json:"brand"`
+	}
+	err = getJSON(ctx, zd, fmt.Sprintf("/brands/%d.json", brandID), &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = setSchemaFields(d, map[string]interface{}{
+		"brand_id":           result.Brand.ID,
+		"signature_template": result.Brand.SignatureTemplate,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateBrandAgentSignature(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	brandID, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = putBrandSignatureTemplate(ctx, zd, brandID, d.Get("signature_template").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return readBrandAgentSignature(ctx, d, zd)
+}
+
+func deleteBrandAgentSignature(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	brandID, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = putBrandSignatureTemplate(ctx, zd, brandID, defaultBrandSignatureTemplate)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// putBrandSignatureTemplate updates only the signature template of the brand
+func putBrandSignatureTemplate(ctx context.Context, zd client.BaseAPI, brandID int64, template string) error {
+	data := map[string]interface{}{
+		"brand": map[string]interface{}{
+			"signature_template": template,
+		},
+	}
+
+	return putJSON(ctx, zd, fmt.Sprintf("/brands/%d.json", brandID), data, nil)
+}
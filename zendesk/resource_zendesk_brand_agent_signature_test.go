@@ -0,0 +1,119 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	. "github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestCreateBrandAgentSignature(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"brand_id":           47,
+			"signature_template": "{{agent.signature}} from Brand 1",
+		},
+	}
+
+	m.EXPECT().Put(Any(), Eq("/brands/47.json"), Any()).Return([]byte(`{"brand": {"id": 47}}`), nil)
+	m.EXPECT().Get(Any(), Eq("/brands/47.json")).Return([]byte(`{"brand": {"id": 47, "signature_template": "{{agent.signature}} from Brand 1"}}`), nil)
+	if diags := createBrandAgentSignature(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createBrandAgentSignature returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "47" {
+		t.Fatalf("createBrandAgentSignature did not set resource id. Id was %s", v)
+	}
+}
+
+func TestReadBrandAgentSignature(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("47")
+
+	m.EXPECT().Get(Any(), Eq("/brands/47.json")).Return([]byte(`{"brand": {"id": 47, "signature_template": "Brand 1 Support"}}`), nil)
+	if diags := readBrandAgentSignature(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readBrandAgentSignature returned an error: %v", diags)
+	}
+
+	if v := i.Get("signature_template"); v != "Brand 1 Support" {
+		t.Fatalf("readBrandAgentSignature did not set signature_template. Was %s", v)
+	}
+
+	if v := i.Get("brand_id"); v != int64(47) {
+		t.Fatalf("readBrandAgentSignature did not set brand_id. Was %v", v)
+	}
+}
+
+func TestUpdateBrandAgentSignature(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "47",
+		mapGetterSetter: mapGetterSetter{
+			"signature_template": "Brand 1 Support",
+		},
+	}
+
+	m.EXPECT().Put(Any(), Eq("/brands/47.json"), Any()).Return([]byte(`{"brand": {"id": 47}}`), nil)
+	m.EXPECT().Get(Any(), Eq("/brands/47.json")).Return([]byte(`{"brand": {"id": 47, "signature_template": "Brand 1 Support"}}`), nil)
+	if diags := updateBrandAgentSignature(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateBrandAgentSignature returned an error: %v", diags)
+	}
+}
+
+func TestDeleteBrandAgentSignature(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("47")
+
+	m.EXPECT().Put(Any(), Eq("/brands/47.json"), Any()).DoAndReturn(func(_ context.Context, _ string, data interface{}) ([]byte, error) {
+		body, err := json.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+		if string(body) != `{"brand":{"signature_template":"{{agent.signature}}"}}` {
+			t.Fatalf("deleteBrandAgentSignature did not restore the default signature template. body was %s", body)
+		}
+		return nil, nil
+	})
+	if diags := deleteBrandAgentSignature(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteBrandAgentSignature returned an error: %v", diags)
+	}
+}
+
+func TestAccBrandAgentSignatureExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testBrandDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: concatExampleConfig(t,
+					readExampleConfig(t, "resources/zendesk_brand/resource.tf"),
+					readExampleConfig(t, "resources/zendesk_brand_agent_signature/resource.tf"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zendesk_brand_agent_signature.T-1000", "signature_template", "{{agent.signature}}\n\nT-1000 Support"),
+				),
+			},
+		},
+	})
+}
@@ -2,13 +2,16 @@ package zendesk
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
 	. "github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/nukosuke/go-zendesk/zendesk"
@@ -73,6 +76,7 @@ func TestReadBrand(t *testing.T) {
 
 	m := mock.NewClient(ctrl)
 	m.EXPECT().GetBrand(Any(), testBrand.ID).Return(testBrand, nil)
+	m.EXPECT().Get(Any(), Eq("/brands/47/check_host_mapping.json")).Return([]byte(`{"is_valid": false, "reason": "wrong_cname"}`), nil)
 	i := newIdentifiableGetterSetter()
 	i.SetId(fmt.Sprintf("%d", testBrand.ID))
 
@@ -84,6 +88,132 @@ func TestReadBrand(t *testing.T) {
 	if v := i.Get("subdomain"); v != testBrand.Subdomain {
 		t.Fatalf("Subdomain was not set to the expected value. Was: %s Expected %s", v, testBrand.Subdomain)
 	}
+
+	if v := i.Get("host_mapping_ssl_status"); v != "wrong_cname" {
+		t.Fatalf("host_mapping_ssl_status was not set to the expected value. Was: %s Expected wrong_cname", v)
+	}
+}
+
+func TestReadBrandWithoutHostMapping(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	brand := testBrand
+	brand.HostMapping = ""
+
+	m := mock.NewClient(ctrl)
+	m.EXPECT().GetBrand(Any(), testBrand.ID).Return(brand, nil)
+	i := newIdentifiableGetterSetter()
+	i.SetId(fmt.Sprintf("%d", testBrand.ID))
+
+	diags := readBrand(context.Background(), i, m)
+	if len(diags) != 0 {
+		t.Fatalf("readBrand returned an error: %v", diags)
+	}
+
+	if v := i.Get("host_mapping_ssl_status"); v != "" {
+		t.Fatalf("host_mapping_ssl_status should be empty without a host mapping. Was: %s", v)
+	}
+}
+
+func TestUnmarshalBrandLogoAttachmentID(t *testing.T) {
+	m := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"name":               "Brand 1",
+			"logo_attachment_id": 928374,
+		},
+	}
+
+	brand, err := unmarshalBrand(m)
+	if err != nil {
+		t.Fatalf("unmarshal returned an error: %v", err)
+	}
+
+	if brand.Logo.ID != 928374 {
+		t.Fatalf("brand had logo id %d. should have been 928374", brand.Logo.ID)
+	}
+}
+
+func TestReadBrandHostMappingCheckFailed(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	m.EXPECT().GetBrand(Any(), testBrand.ID).Return(testBrand, nil)
+	m.EXPECT().Get(Any(), Eq("/brands/47/check_host_mapping.json")).Return(nil, fmt.Errorf("timeout"))
+	i := newIdentifiableGetterSetter()
+	i.SetId(fmt.Sprintf("%d", testBrand.ID))
+
+	diags := readBrand(context.Background(), i, m)
+	if diags.HasError() {
+		t.Fatalf("readBrand should not fail when the host mapping check fails: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("readBrand should warn about the failed host mapping check. Was: %v", diags)
+	}
+
+	if v := i.Get("host_mapping_ssl_status"); v != "check_failed" {
+		t.Fatalf("host_mapping_ssl_status was not set to the expected value. Was: %s Expected check_failed", v)
+	}
+}
+
+func TestUpdateBrandLogoRemoved(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	// no upload or update is expected when logo_file_path is removed
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "47",
+		mapGetterSetter: mapGetterSetter{
+			"logo_file_path": "",
+		},
+	}
+
+	diags := updateBrandLogo(context.Background(), i, m)
+	if len(diags) != 0 {
+		t.Fatalf("updateBrandLogo returned an error: %v", diags)
+	}
+}
+
+func TestCreateBrandUploadsLogo(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+
+	w := newMockUploadWriter(zendesk.Upload{Token: "logo-token"}, nil)
+	withLogo := []byte(`{"brand": {"id": 47, "name": "Brand 1", "logo": {"id": 1001, "file_name": "street.jpg"}}}`)
+
+	m.EXPECT().CreateBrand(Any(), Any()).Return(testBrand, nil)
+	m.EXPECT().UploadAttachment(Any(), Eq("street.jpg"), Eq("")).Return(w)
+	m.EXPECT().Put(Any(), Eq("/brands/47.json"), Any()).DoAndReturn(func(_ context.Context, _ string, data interface{}) ([]byte, error) {
+		body, err := json.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+		if !strings.Contains(string(body), `"token":"logo-token"`) {
+			t.Fatalf("brand update did not send the upload token: %s", body)
+		}
+		return withLogo, nil
+	})
+
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"name":           "Brand 1",
+			"subdomain":      "brand1",
+			"logo_file_path": "testdata/street.jpg",
+			"logo_file_hash": "foo",
+		},
+	}
+	diags := createBrand(context.Background(), i, m)
+	if len(diags) != 0 {
+		t.Fatalf("Create brand returned an error %v", diags)
+	}
+
+	if v := i.Get("logo_attachment_id"); v != int64(1001) {
+		t.Fatalf("Created object does not have the uploaded logo id. Was: %v. Expected 1001", v)
+	}
 }
 
 func TestUpdateBrand(t *testing.T) {
@@ -0,0 +1,314 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// Number of ticket forms fetched per page while listing
+const ticketFormsPerPage = 100
+
+// https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_forms/
+func resourceZendeskBrandTicketForms() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the ticket forms offered by a brand. The association is authoritative: ticket forms restricted to the brand that are not listed are removed from it. Listed ticket forms must have `in_all_brands` set to false.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.TicketFormAPI)
+			return createBrandTicketForms(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.TicketFormAPI)
+			return readBrandTicketForms(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.TicketFormAPI)
+			return updateBrandTicketForms(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.TicketFormAPI)
+			return deleteBrandTicketForms(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+				id, err := atoi64(d.Id())
+				if err != nil {
+					return nil, fmt.Errorf("could not parse brand id %s: %v", d.Id(), err)
+				}
+				return []*schema.ResourceData{d}, d.Set("brand_id", id)
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"brand_id": {
+				Description: "The id of the brand.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"ticket_form_ids": {
+				Description: "The ids of the ticket forms offered by the brand.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Required: true,
+			},
+		},
+	}
+}
+
+func createBrandTicketForms(ctx context.Context, d identifiableGetterSetter, zd client.TicketFormAPI) diag.Diagnostics {
+	brandID := int64(d.Get("brand_id").(int))
+
+	diags := setBrandTicketForms(ctx, zd, brandID, unmarshalBrandTicketFormIDs(d))
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(fmt.Sprintf("%d", brandID))
+
+	return append(diags, readBrandTicketForms(ctx, d, zd)...)
+}
+
+func readBrandTicketForms(ctx context.Context, d identifiableGetterSetter, zd client.TicketFormAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	brandID, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	forms, err := listTicketForms(ctx, zd)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := make([]int64, 0)
+	for _, form := range forms {
+		if !form.InAllBrands && int64SliceContains(form.RestrictedBrandIDs, brandID) {
+			ids = append(ids, form.ID)
+		}
+	}
+
+	err = setSchemaFields(d, map[string]interface{}{
+		"brand_id":        brandID,
+		"ticket_form_ids": ids,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateBrandTicketForms(ctx context.Context, d identifiableGetterSetter, zd client.TicketFormAPI) diag.Diagnostics {
+	brandID, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags := setBrandTicketForms(ctx, zd, brandID, unmarshalBrandTicketFormIDs(d))
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, readBrandTicketForms(ctx, d, zd)...)
+}
+
+// Removes the brand from every ticket form restricted to it. Forms that would
+// not be assigned to any brand anymore are left untouched.
+func deleteBrandTicketForms(ctx context.Context, d identifiable, zd client.TicketFormAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	brandID, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	forms, err := listTicketForms(ctx, zd)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, form := range forms {
+		if form.InAllBrands || !int64SliceContains(form.RestrictedBrandIDs, brandID) {
+			continue
+		}
+
+		err = updateTicketFormBrand(ctx, zd, form.ID, brandID, false)
+		if errors.Is(err, errTicketFormLastBrand) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("ticket form %d is still assigned to brand %d", form.ID, brandID),
+				Detail:   fmt.Sprintf("ticket form %q is not available in all brands and brand %d is the only brand it is assigned to.", form.Name, brandID),
+			})
+			continue
+		}
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
+
+// setBrandTicketForms makes the brand offer exactly the given ticket forms.
+// Every form is validated before any of them is updated.
+func setBrandTicketForms(ctx context.Context, zd client.TicketFormAPI, brandID int64, formIDs []int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	forms, err := listTicketForms(ctx, zd)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	byID := make(map[int64]client.TicketForm, len(forms))
+	for _, form := range forms {
+		byID[form.ID] = form
+	}
+
+	var assigned, removed []int64
+	for _, id := range formIDs {
+		form, ok := byID[id]
+		if !ok {
+			return diag.Errorf("ticket form %d does not exist", id)
+		}
+
+		if form.InAllBrands {
+			return diag.Errorf("ticket form %d is available in all brands. set in_all_brands to false to assign it to brand %d", id, brandID)
+		}
+
+		if !int64SliceContains(form.RestrictedBrandIDs, brandID) {
+			assigned = append(assigned, id)
+		}
+	}
+
+	for _, form := range forms {
+		if form.InAllBrands || int64SliceContains(formIDs, form.ID) || !int64SliceContains(form.RestrictedBrandIDs, brandID) {
+			continue
+		}
+
+		if len(int64SliceRemove(form.RestrictedBrandIDs, brandID)) == 0 {
+			return diag.Errorf("ticket form %d is not available in all brands and would not be assigned to any brand once removed from brand %d. assign it to another brand first", form.ID, brandID)
+		}
+		removed = append(removed, form.ID)
+	}
+
+	for _, id := range assigned {
+		err = updateTicketFormBrand(ctx, zd, id, brandID, true)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	for _, id := range removed {
+		err = updateTicketFormBrand(ctx, zd, id, brandID, false)
+		if errors.Is(err, errTicketFormLastBrand) {
+			return diag.Errorf("ticket form %d is not available in all brands and would not be assigned to any brand once removed from brand %d. assign it to another brand first", id, brandID)
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+// errTicketFormLastBrand is returned when removing a brand would leave a ticket form without any brand
+var errTicketFormLastBrand = errors.New("the brand is the only brand of the ticket form")
+
+// ticketFormLocks serializes the updates of each ticket form. Brands sharing a form
+// are applied in parallel and would otherwise overwrite each other's restricted_brand_ids.
+var ticketFormLocks = struct {
+	sync.Mutex
+	forms map[int64]*sync.Mutex
+}{forms: map[int64]*sync.Mutex{}}
+
+func lockTicketForm(id int64) func() {
+	ticketFormLocks.Lock()
+	l, ok := ticketFormLocks.forms[id]
+	if !ok {
+		l = &sync.Mutex{}
+		ticketFormLocks.forms[id] = l
+	}
+	ticketFormLocks.Unlock()
+
+	l.Lock()
+	return l.Unlock
+}
+
+// updateTicketFormBrand assigns the brand to the ticket form or removes it from the form.
+// The form is read again under its lock, so that concurrent changes for other brands are kept.
+func updateTicketFormBrand(ctx context.Context, zd client.TicketFormAPI, formID, brandID int64, assign bool) error {
+	defer lockTicketForm(formID)()
+
+	form, err := zd.GetTicketForm(ctx, formID)
+	if err != nil {
+		return err
+	}
+
+	if assign {
+		if int64SliceContains(form.RestrictedBrandIDs, brandID) {
+			return nil
+		}
+		form.RestrictedBrandIDs = append(form.RestrictedBrandIDs, brandID)
+	} else {
+		if !int64SliceContains(form.RestrictedBrandIDs, brandID) {
+			return nil
+		}
+		remaining := int64SliceRemove(form.RestrictedBrandIDs, brandID)
+		if len(remaining) == 0 {
+			return errTicketFormLastBrand
+		}
+		form.RestrictedBrandIDs = remaining
+	}
+
+	_, err = zd.UpdateTicketForm(ctx, formID, form)
+	return err
+}
+
+func unmarshalBrandTicketFormIDs(d getter) []int64 {
+	var ids []int64
+	if v, ok := d.GetOk("ticket_form_ids"); ok {
+		for _, id := range v.(*schema.Set).List() {
+			ids = append(ids, int64(id.(int)))
+		}
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// listTicketForms fetches every ticket form of the account
+func listTicketForms(ctx context.Context, zd client.TicketFormAPI) ([]client.TicketForm, error) {
+	var forms []client.TicketForm
+
+	opts := &client.TicketFormListOptions{
+		PageOptions: client.PageOptions{
+			PerPage: ticketFormsPerPage,
+			Page:    1,
+		},
+	}
+
+	for {
+		page, p, err := zd.GetTicketForms(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		forms = append(forms, page...)
+		if !p.HasNext() {
+			break
+		}
+		opts.Page++
+	}
+
+	return forms, nil
+}
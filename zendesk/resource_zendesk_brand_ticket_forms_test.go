@@ -0,0 +1,234 @@
+package zendesk
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	. "github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nukosuke/go-zendesk/zendesk"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+var testBrandTicketForms = []zendesk.TicketForm{
+	{ID: 1, Name: "Everywhere", InAllBrands: true},
+	{ID: 2, Name: "Shared", RestrictedBrandIDs: []int64{47, 48}},
+	{ID: 3, Name: "Other brand", RestrictedBrandIDs: []int64{48}},
+	{ID: 4, Name: "Only this brand", RestrictedBrandIDs: []int64{47}},
+}
+
+func newBrandTicketFormsGetterSetter(formIDs ...interface{}) *identifiableMapGetterSetter {
+	return &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"brand_id":        47,
+			"ticket_form_ids": schema.NewSet(schema.HashInt, formIDs),
+		},
+	}
+}
+
+func TestReadBrandTicketForms(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("47")
+
+	m.EXPECT().GetTicketForms(Any(), Any()).Return(testBrandTicketForms, zendesk.Page{}, nil)
+	if diags := readBrandTicketForms(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readBrandTicketForms returned an error: %v", diags)
+	}
+
+	ids := i.Get("ticket_form_ids").([]int64)
+	if len(ids) != 2 || ids[0] != 2 || ids[1] != 4 {
+		t.Fatalf("readBrandTicketForms set ticket_form_ids to %v. should have been [2 4]", ids)
+	}
+}
+
+func TestCreateBrandTicketForms(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newBrandTicketFormsGetterSetter(2, 3, 4)
+
+	m.EXPECT().GetTicketForms(Any(), Any()).Return(testBrandTicketForms, zendesk.Page{}, nil).Times(2)
+	m.EXPECT().GetTicketForm(Any(), Eq(int64(3))).Return(testBrandTicketForms[2], nil)
+	m.EXPECT().UpdateTicketForm(Any(), Eq(int64(3)), Any()).DoAndReturn(func(_ context.Context, _ int64, form zendesk.TicketForm) (zendesk.TicketForm, error) {
+		if !int64SliceContains(form.RestrictedBrandIDs, 47) || !int64SliceContains(form.RestrictedBrandIDs, 48) {
+			t.Fatalf("ticket form 3 should have been assigned to brands 47 and 48. was %v", form.RestrictedBrandIDs)
+		}
+		return form, nil
+	})
+
+	if diags := createBrandTicketForms(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createBrandTicketForms returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "47" {
+		t.Fatalf("createBrandTicketForms did not set resource id. Id was %s", v)
+	}
+}
+
+func TestCreateBrandTicketFormsInAllBrands(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newBrandTicketFormsGetterSetter(1)
+
+	m.EXPECT().GetTicketForms(Any(), Any()).Return(testBrandTicketForms, zendesk.Page{}, nil)
+	if diags := createBrandTicketForms(context.Background(), i, m); !diags.HasError() {
+		t.Fatal("createBrandTicketForms should reject forms available in all brands")
+	}
+}
+
+func TestUpdateBrandTicketForms(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newBrandTicketFormsGetterSetter(4)
+	i.SetId("47")
+
+	m.EXPECT().GetTicketForms(Any(), Any()).Return(testBrandTicketForms, zendesk.Page{}, nil).Times(2)
+	m.EXPECT().GetTicketForm(Any(), Eq(int64(2))).Return(testBrandTicketForms[1], nil)
+	m.EXPECT().UpdateTicketForm(Any(), Eq(int64(2)), Any()).DoAndReturn(func(_ context.Context, _ int64, form zendesk.TicketForm) (zendesk.TicketForm, error) {
+		if len(form.RestrictedBrandIDs) != 1 || form.RestrictedBrandIDs[0] != 48 {
+			t.Fatalf("brand 47 should have been removed from ticket form 2. brands were %v", form.RestrictedBrandIDs)
+		}
+		return form, nil
+	})
+
+	if diags := updateBrandTicketForms(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateBrandTicketForms returned an error: %v", diags)
+	}
+}
+
+func TestUpdateBrandTicketFormsLeavesFormUnassigned(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newBrandTicketFormsGetterSetter(2)
+	i.SetId("47")
+
+	m.EXPECT().GetTicketForms(Any(), Any()).Return(testBrandTicketForms, zendesk.Page{}, nil)
+	if diags := updateBrandTicketForms(context.Background(), i, m); !diags.HasError() {
+		t.Fatal("updateBrandTicketForms should not leave ticket form 4 without a brand")
+	}
+}
+
+func TestDeleteBrandTicketForms(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("47")
+
+	m.EXPECT().GetTicketForms(Any(), Any()).Return(testBrandTicketForms, zendesk.Page{}, nil)
+	m.EXPECT().GetTicketForm(Any(), Eq(int64(2))).Return(testBrandTicketForms[1], nil)
+	m.EXPECT().GetTicketForm(Any(), Eq(int64(4))).Return(testBrandTicketForms[3], nil)
+	m.EXPECT().UpdateTicketForm(Any(), Eq(int64(2)), Any()).Return(zendesk.TicketForm{}, nil)
+
+	diags := deleteBrandTicketForms(context.Background(), i, m)
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("deleteBrandTicketForms should warn about ticket form 4. diags were %v", diags)
+	}
+}
+
+func TestSetBrandTicketFormsSharedForm(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	// the mock keeps the ticket form, as the API would, so that lost updates show up
+	var mu sync.Mutex
+	form := zendesk.TicketForm{ID: 5, Name: "Shared", RestrictedBrandIDs: []int64{46}}
+
+	m := mock.NewClient(ctrl)
+	m.EXPECT().GetTicketForms(Any(), Any()).DoAndReturn(func(_ context.Context, _ *zendesk.TicketFormListOptions) ([]zendesk.TicketForm, zendesk.Page, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		f := form
+		f.RestrictedBrandIDs = append([]int64{}, form.RestrictedBrandIDs...)
+		return []zendesk.TicketForm{f}, zendesk.Page{}, nil
+	}).Times(2)
+	m.EXPECT().GetTicketForm(Any(), Eq(int64(5))).DoAndReturn(func(_ context.Context, _ int64) (zendesk.TicketForm, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		f := form
+		f.RestrictedBrandIDs = append([]int64{}, form.RestrictedBrandIDs...)
+		return f, nil
+	}).Times(2)
+	m.EXPECT().UpdateTicketForm(Any(), Eq(int64(5)), Any()).DoAndReturn(func(_ context.Context, _ int64, f zendesk.TicketForm) (zendesk.TicketForm, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		form = f
+		return f, nil
+	}).Times(2)
+
+	var wg sync.WaitGroup
+	for _, brandID := range []int64{47, 48} {
+		wg.Add(1)
+		go func(brandID int64) {
+			defer wg.Done()
+			if diags := setBrandTicketForms(context.Background(), m, brandID, []int64{5}); len(diags) != 0 {
+				t.Errorf("setBrandTicketForms returned an error for brand %d: %v", brandID, diags)
+			}
+		}(brandID)
+	}
+	wg.Wait()
+
+	for _, brandID := range []int64{46, 47, 48} {
+		if !int64SliceContains(form.RestrictedBrandIDs, brandID) {
+			t.Fatalf("ticket form 5 should be assigned to brands 46, 47 and 48. brands were %v", form.RestrictedBrandIDs)
+		}
+	}
+}
+
+func TestListTicketFormsPaginates(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	next := "https://example.zendesk.com/api/v2/ticket_forms.json?page=2"
+
+	InOrder(
+		m.EXPECT().GetTicketForms(Any(), Any()).Return(testBrandTicketForms[:2], zendesk.Page{NextPage: &next}, nil),
+		m.EXPECT().GetTicketForms(Any(), Any()).Return(testBrandTicketForms[2:], zendesk.Page{}, nil),
+	)
+
+	forms, err := listTicketForms(context.Background(), m)
+	if err != nil {
+		t.Fatalf("listTicketForms returned an error: %v", err)
+	}
+
+	if len(forms) != len(testBrandTicketForms) {
+		t.Fatalf("listTicketForms returned %d forms. should have been %d", len(forms), len(testBrandTicketForms))
+	}
+}
+
+func TestAccBrandTicketFormsExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testTicketFormDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: concatExampleConfig(t,
+					readExampleConfig(t, "resources/zendesk_brand/resource.tf"),
+					readExampleConfig(t, "resources/zendesk_brand_ticket_forms/resource.tf"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zendesk_brand_ticket_forms.T-800", "ticket_form_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,213 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// customObject is the custom object JSON payload format
+//
+// ref: https://developer.zendesk.com/api-reference/custom-data/custom-objects/custom_objects/#json-format
+type customObject struct {
+	URL               string     `json:"url,omitempty"`
+	Key               string     `json:"key,omitempty"`
+	Title             string     `json:"title"`
+	TitlePluralized   string     `json:"title_pluralized"`
+	Description       string     `json:"description"`
+	IncludeInListView bool       `json:"include_in_list_view"`
+	CreatedAt         *time.Time `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `json:"updated_at,omitempty"`
+}
+
+// Keys of custom objects and their fields
+var customObjectKeyRegexp = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// https://developer.zendesk.com/api-reference/custom-data/custom-objects/custom_objects/
+func resourceZendeskCustomObject() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a custom object resource. Its fields are managed with `zendesk_custom_object_field`.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return createCustomObject(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return readCustomObject(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return updateCustomObject(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return deleteCustomObject(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Description:  "The unique key of the custom object, e.g. used in lookup fields as \"zen:custom_object:<key>\". Lowercase letters, digits and underscores only.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(customObjectKeyRegexp, "must only contain lowercase letters, digits and underscores"),
+			},
+			"title": {
+				Description: "The title of the custom object.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"title_pluralized": {
+				Description: "The pluralized title of the custom object.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The description of the custom object.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
+			"include_in_list_view": {
+				Description: "Whether the custom object is listed in the agent workspace.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"url": {
+				Description: "The API url of the custom object.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func marshalCustomObject(object customObject, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"key":                  object.Key,
+		"title":                object.Title,
+		"title_pluralized":     object.TitlePluralized,
+		"description":          object.Description,
+		"include_in_list_view": object.IncludeInListView,
+		"url":                  object.URL,
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+func unmarshalCustomObject(d identifiableGetterSetter) customObject {
+	object := customObject{}
+
+	if v, ok := d.GetOk("key"); ok {
+		object.Key = v.(string)
+	}
+
+	if v, ok := d.GetOk("title"); ok {
+		object.Title = v.(string)
+	}
+
+	if v, ok := d.GetOk("title_pluralized"); ok {
+		object.TitlePluralized = v.(string)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		object.Description = v.(string)
+	}
+
+	if v, ok := d.GetOk("include_in_list_view"); ok {
+		object.IncludeInListView = v.(bool)
+	}
+
+	return object
+}
+
+func createCustomObject(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var data, result struct {
+		CustomObject customObject `json:"custom_object"`
+	}
+	data.CustomObject = unmarshalCustomObject(d)
+
+	err := postJSON(ctx, zd, "/custom_objects.json", data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(result.CustomObject.Key)
+
+	err = marshalCustomObject(result.CustomObject, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readCustomObject(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var result struct {
+		CustomObject customObject `json:"custom_object"`
+	}
+	err := getJSON(ctx, zd, fmt.Sprintf("/custom_objects/%s.json", d.Id()), &result)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalCustomObject(result.CustomObject, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateCustomObject(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var data, result struct {
+		CustomObject customObject `json:"custom_object"`
+	}
+	data.CustomObject = unmarshalCustomObject(d)
+	// the key can't be changed after creation
+	data.CustomObject.Key = ""
+
+	// custom objects are only updated with PATCH
+	err := patchJSON(ctx, zd, fmt.Sprintf("/custom_objects/%s.json", d.Id()), data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalCustomObject(result.CustomObject, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func deleteCustomObject(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := zd.Delete(ctx, fmt.Sprintf("/custom_objects/%s.json", d.Id()))
+	if err != nil && !isNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
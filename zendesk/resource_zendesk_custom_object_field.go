@@ -0,0 +1,328 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// customObjectField is the custom object field JSON payload format
+//
+// ref: https://developer.zendesk.com/api-reference/custom-data/custom-objects/custom_object_fields/#json-format
+type customObjectField struct {
+	ID                     int64                      `json:"id,omitempty"`
+	URL                    string                     `json:"url,omitempty"`
+	Key                    string                     `json:"key,omitempty"`
+	Type                   string                     `json:"type,omitempty"`
+	Title                  string                     `json:"title"`
+	Description            string                     `json:"description"`
+	Position               int64                      `json:"position,omitempty"`
+	Active                 bool                       `json:"active"`
+	RegexpForValidation    string                     `json:"regexp_for_validation,omitempty"`
+	Tag                    string                     `json:"tag,omitempty"`
+	CustomFieldOptions     []client.CustomFieldOption `json:"custom_field_options,omitempty"`
+	RelationshipTargetType string                     `json:"relationship_target_type,omitempty"`
+	RelationshipFilter     *fieldRelationshipFilter   `json:"relationship_filter,omitempty"`
+	CreatedAt              *time.Time                 `json:"created_at,omitempty"`
+	UpdatedAt              *time.Time                 `json:"updated_at,omitempty"`
+}
+
+// https://developer.zendesk.com/api-reference/custom-data/custom-objects/custom_object_fields/
+func resourceZendeskCustomObjectField() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a field of a custom object. Import with the id `<custom_object_key>/<key>`.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return createCustomObjectField(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return readCustomObjectField(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return updateCustomObjectField(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return deleteCustomObjectField(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+				objectKey, key, err := parseCustomObjectFieldID(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				err = setSchemaFields(d, map[string]interface{}{
+					"custom_object_key": objectKey,
+					"key":               key,
+				})
+				return []*schema.ResourceData{d}, err
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, i interface{}) error {
+			for _, k := range customFieldValidatedKeys {
+				if !d.NewValueKnown(k) {
+					return nil
+				}
+			}
+			return validateCustomField(d, d.Get("type").(string))
+		},
+
+		Schema: map[string]*schema.Schema{
+			"custom_object_key": {
+				Description: "The key of the custom object the field belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"key": {
+				Description:  "The unique key of the field within its custom object. Lowercase letters, digits and underscores only.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(customObjectKeyRegexp, "must only contain lowercase letters, digits and underscores"),
+			},
+			"type": {
+				Description: "The type of the field. Can't be changed after creation.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"checkbox",
+					"date",
+					"decimal",
+					"dropdown",
+					"integer",
+					"lookup",
+					"multiselect",
+					"regexp",
+					"text",
+					"textarea",
+				}, false),
+			},
+			"title": {
+				Description: "The title of the field.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "Describes the purpose of the field to users.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
+			"position": {
+				Description: "The relative position of the field on the custom object records.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"active": {
+				Description: "Whether the field is available.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"regexp_for_validation": {
+				Description: `For "regexp" fields only. The validation pattern for a field value to be deemed valid.`,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"tag": {
+				Description: `For "checkbox" fields only. A tag added to records when the checkbox field is selected.`,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"custom_field_option":      customFieldOptionSchema(`Required for fields of type "dropdown" or "multiselect". Options are displayed in the listed order and are identified by their value, so renaming an option keeps its id.`),
+			"relationship_target_type": fieldRelationshipTargetTypeSchema(),
+			"relationship_filter":      fieldRelationshipFilterSchema(),
+			"url": {
+				Description: "The API url of the field.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func parseCustomObjectFieldID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("could not parse custom object field id %s: should be <custom_object_key>/<key>", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func customObjectFieldPath(d getter) string {
+	return fmt.Sprintf("/custom_objects/%s/fields/%s.json", d.Get("custom_object_key").(string), d.Get("key").(string))
+}
+
+func marshalCustomObjectField(field customObjectField, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"key":                      field.Key,
+		"type":                     field.Type,
+		"title":                    field.Title,
+		"description":              field.Description,
+		"position":                 field.Position,
+		"active":                   field.Active,
+		"regexp_for_validation":    field.RegexpForValidation,
+		"tag":                      field.Tag,
+		"custom_field_option":      marshalCustomFieldOptions(field.CustomFieldOptions),
+		"relationship_target_type": field.RelationshipTargetType,
+		"relationship_filter":      marshalFieldRelationshipFilter(field.RelationshipFilter),
+		"url":                      field.URL,
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+func unmarshalCustomObjectField(d identifiableGetterSetter) (customObjectField, error) {
+	field := customObjectField{}
+
+	if v, ok := d.GetOk("key"); ok {
+		field.Key = v.(string)
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		field.Type = v.(string)
+	}
+
+	if v, ok := d.GetOk("title"); ok {
+		field.Title = v.(string)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		field.Description = v.(string)
+	}
+
+	if v, ok := d.GetOk("position"); ok {
+		field.Position = int64(v.(int))
+	}
+
+	if v, ok := d.GetOk("active"); ok {
+		field.Active = v.(bool)
+	}
+
+	if v, ok := d.GetOk("regexp_for_validation"); ok {
+		field.RegexpForValidation = v.(string)
+	}
+
+	if v, ok := d.GetOk("tag"); ok {
+		field.Tag = v.(string)
+	}
+
+	options, err := unmarshalCustomFieldOptions(d)
+	if err != nil {
+		return field, err
+	}
+	field.CustomFieldOptions = options
+
+	if v, ok := d.GetOk("relationship_target_type"); ok {
+		field.RelationshipTargetType = v.(string)
+	}
+
+	field.RelationshipFilter = unmarshalFieldRelationshipFilter(d)
+
+	return field, nil
+}
+
+func createCustomObjectField(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	field, err := unmarshalCustomObjectField(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var data, result struct {
+		CustomObjectField customObjectField `json:"custom_object_field"`
+	}
+	data.CustomObjectField = field
+
+	objectKey := d.Get("custom_object_key").(string)
+	err = postJSON(ctx, zd, fmt.Sprintf("/custom_objects/%s/fields.json", objectKey), data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", objectKey, result.CustomObjectField.Key))
+
+	err = marshalCustomObjectField(result.CustomObjectField, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readCustomObjectField(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var result struct {
+		CustomObjectField customObjectField `json:"custom_object_field"`
+	}
+	err := getJSON(ctx, zd, customObjectFieldPath(d), &result)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalCustomObjectField(result.CustomObjectField, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateCustomObjectField(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	field, err := unmarshalCustomObjectField(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var data, result struct {
+		CustomObjectField customObjectField `json:"custom_object_field"`
+	}
+	data.CustomObjectField = field
+	// key, type and relationship target can't be changed after creation
+	data.CustomObjectField.Key = ""
+	data.CustomObjectField.Type = ""
+	data.CustomObjectField.RelationshipTargetType = ""
+
+	// custom object fields are only updated with PATCH
+	err = patchJSON(ctx, zd, customObjectFieldPath(d), data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalCustomObjectField(result.CustomObjectField, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func deleteCustomObjectField(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := zd.Delete(ctx, customObjectFieldPath(d))
+	if err != nil && !isNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
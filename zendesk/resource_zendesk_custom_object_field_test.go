@@ -0,0 +1,273 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nukosuke/go-zendesk/zendesk"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestParseCustomObjectFieldID(t *testing.T) {
+	objectKey, key, err := parseCustomObjectFieldID("asset/model")
+	if err != nil {
+		t.Fatalf("parse returned an error: %v", err)
+	}
+
+	if objectKey != "asset" || key != "model" {
+		t.Fatalf("parsed custom object key %s and field key %s. should have been asset and model", objectKey, key)
+	}
+
+	for _, id := range []string{"model", "asset/", "asset/model/extra"} {
+		if _, _, err := parseCustomObjectFieldID(id); err == nil {
+			t.Fatalf("parse should reject the id %s", id)
+		}
+	}
+}
+
+func TestUnmarshalCustomObjectFieldMatchesOptionsByValue(t *testing.T) {
+	d := changeGetterSetter{
+		identifiableMapGetterSetter: &identifiableMapGetterSetter{
+			id: "asset/model",
+			mapGetterSetter: mapGetterSetter{
+				"custom_object_key": "asset",
+				"key":               "model",
+				"type":              "dropdown",
+				"title":             "Model",
+				"custom_field_option": []interface{}{
+					map[string]interface{}{"name": "T-1000", "value": "t1000", "id": 101},
+					map[string]interface{}{"name": "T-800", "value": "t800", "id": 102},
+				},
+			},
+		},
+		old: mapGetterSetter{
+			"custom_field_option": []interface{}{
+				map[string]interface{}{"name": "T-800", "value": "t800", "id": 101},
+				map[string]interface{}{"name": "T-1000", "value": "t1000", "id": 102},
+			},
+		},
+	}
+
+	field, err := unmarshalCustomObjectField(d)
+	if err != nil {
+		t.Fatalf("unmarshal returned an error: %v", err)
+	}
+
+	expected := []zendesk.CustomFieldOption{
+		{ID: 102, Name: "T-1000", Value: "t1000"},
+		{ID: 101, Name: "T-800", Value: "t800"},
+	}
+	if !reflect.DeepEqual(field.CustomFieldOptions, expected) {
+		t.Fatalf("custom field options were %v. should have been %v", field.CustomFieldOptions, expected)
+	}
+}
+
+func TestValidateCustomObjectField(t *testing.T) {
+	cases := []struct {
+		name  string
+		attrs mapGetterSetter
+		valid bool
+	}{
+		{"dropdown field", mapGetterSetter{"type": "dropdown", "custom_field_option": []interface{}{map[string]interface{}{"name": "T-800", "value": "t800"}}}, true},
+		{"dropdown field without options", mapGetterSetter{"type": "dropdown"}, false},
+		{"lookup field", mapGetterSetter{"type": "lookup", "relationship_target_type": "zen:custom_object:asset"}, true},
+		{"regexp on text field", mapGetterSetter{"type": "text", "regexp_for_validation": "^[0-9]+$"}, false},
+	}
+
+	for _, c := range cases {
+		err := validateCustomField(c.attrs, c.attrs.Get("type").(string))
+		if c.valid && err != nil {
+			t.Fatalf("%s: should be valid but got %v", c.name, err)
+		}
+		if !c.valid && err == nil {
+			t.Fatalf("%s: should be invalid", c.name)
+		}
+	}
+}
+
+func TestCustomObjectFieldDiffWithUnknownRelationshipTarget(t *testing.T) {
+	// a lookup field targeting a custom object created in the same apply
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"custom_object_key":        "asset",
+		"key":                      "owner",
+		"type":                     "lookup",
+		"title":                    "Owner",
+		"relationship_target_type": unknownConfigValue,
+	})
+	if _, err := resourceZendeskCustomObjectField().Diff(context.Background(), nil, config, nil); err != nil {
+		t.Fatalf("plan with an unknown relationship_target_type returned an error: %v", err)
+	}
+
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"custom_object_key": "asset",
+		"key":               "owner",
+		"type":              "lookup",
+		"title":             "Owner",
+	})
+	if _, err := resourceZendeskCustomObjectField().Diff(context.Background(), nil, config, nil); err == nil {
+		t.Fatal("plan without relationship_target_type should have returned an error")
+	}
+}
+
+func TestCreateCustomObjectField(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"custom_object_key": "asset",
+			"key":               "owner",
+			"type":              "lookup",
+			"title":             "Owner",
+		},
+	}
+	out := []byte(`{"custom_object_field": {"id": 1, "key": "owner", "type": "lookup", "title": "Owner", "relationship_target_type": "zen:user"}}`)
+
+	m.EXPECT().Post(gomock.Any(), gomock.Eq("/custom_objects/asset/fields.json"), gomock.Any()).Return(out, nil)
+	if diags := createCustomObjectField(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createCustomObjectField returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "asset/owner" {
+		t.Fatalf("createCustomObjectField did not set the resource id. Id was %s", v)
+	}
+
+	if v := i.Get("relationship_target_type"); v != "zen:user" {
+		t.Fatalf("createCustomObjectField did not set relationship_target_type. relationship_target_type was %v", v)
+	}
+}
+
+func TestReadCustomObjectField(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "asset/model",
+		mapGetterSetter: mapGetterSetter{
+			"custom_object_key": "asset",
+			"key":               "model",
+		},
+	}
+
+	out := []byte(`{"custom_object_field": {"id": 1, "key": "model", "type": "dropdown", "title": "Model", "active": true,
+		"custom_field_options": [{"id": 101, "name": "T-800", "value": "t800"}]}}`)
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/custom_objects/asset/fields/model.json")).Return(out, nil)
+	if diags := readCustomObjectField(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readCustomObjectField returned an error: %v", diags)
+	}
+
+	options := i.Get("custom_field_option").([]map[string]interface{})
+	if len(options) != 1 || options[0]["id"] != int64(101) {
+		t.Fatalf("readCustomObjectField did not set custom_field_option. custom_field_option was %v", options)
+	}
+}
+
+func TestUpdateCustomObjectFieldPatches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "asset/owner",
+		mapGetterSetter: mapGetterSetter{
+			"custom_object_key":        "asset",
+			"key":                      "owner",
+			"type":                     "lookup",
+			"title":                    "Assigned to",
+			"relationship_target_type": "zen:user",
+		},
+	}
+
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/custom_objects/asset/fields/owner.json"), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, path string, data interface{}) ([]byte, error) {
+			if method := ctx.Value(methodKey{}); method != http.MethodPatch {
+				t.Fatalf("custom object field was updated with %v. should have been PATCH", method)
+			}
+			field := data.(struct {
+				CustomObjectField customObjectField `json:"custom_object_field"`
+			}).CustomObjectField
+			if field.Key != "" || field.Type != "" || field.RelationshipTargetType != "" {
+				t.Fatalf("update sent attributes which can't be changed: %v", field)
+			}
+			return []byte(`{"custom_object_field": {"id": 1, "key": "owner", "type": "lookup", "title": "Assigned to", "relationship_target_type": "zen:user"}}`), nil
+		})
+	if diags := updateCustomObjectField(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateCustomObjectField returned an error: %v", diags)
+	}
+}
+
+func TestDeleteCustomObjectField(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "asset/model",
+		mapGetterSetter: mapGetterSetter{
+			"custom_object_key": "asset",
+			"key":               "model",
+		},
+	}
+
+	m.EXPECT().Delete(gomock.Any(), gomock.Eq("/custom_objects/asset/fields/model.json")).Return(nil)
+	if diags := deleteCustomObjectField(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteCustomObjectField returned an error: %v", diags)
+	}
+}
+
+func testCustomObjectFieldDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(zendesk.BaseAPI)
+
+	for k, r := range s.RootModule().Resources {
+		if r.Type != "zendesk_custom_object_field" {
+			continue
+		}
+
+		objectKey, key, err := parseCustomObjectFieldID(r.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = client.Get(context.Background(), fmt.Sprintf("/custom_objects/%s/fields/%s.json", objectKey, key))
+		if err == nil {
+			return fmt.Errorf("did not get error from zendesk when trying to fetch the destroyed custom object field named %s", k)
+		}
+
+		if !isNotFound(err) {
+			return fmt.Errorf("did not get a not found error after destroy. error was %v", err)
+		}
+	}
+
+	return nil
+}
+
+func TestAccCustomObjectFieldExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCustomObjectFieldDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: concatExampleConfig(t,
+					readExampleConfig(t, "resources/zendesk_custom_object/resource.tf"),
+					readExampleConfig(t, "resources/zendesk_custom_object_field/resource.tf"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zendesk_custom_object_field.asset-serial-number", "type", "regexp"),
+					resource.TestCheckResourceAttr("zendesk_custom_object_field.asset-model", "custom_field_option.1.value", "t1000"),
+					resource.TestCheckResourceAttr("zendesk_custom_object_field.asset-owner", "relationship_target_type", "zen:user"),
+				),
+			},
+		},
+	})
+}
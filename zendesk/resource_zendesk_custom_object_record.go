@@ -0,0 +1,341 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// customObjectRecord is the custom object record JSON payload format
+//
+// ref: https://developer.zendesk.com/api-reference/custom-data/custom-objects/custom_object_records/#json-format
+type customObjectRecord struct {
+	ID                 string                 `json:"id,omitempty"`
+	URL                string                 `json:"url,omitempty"`
+	Name               string                 `json:"name,omitempty"`
+	ExternalID         string                 `json:"external_id,omitempty"`
+	CustomObjectFields map[string]interface{} `json:"custom_object_fields"`
+}
+
+// https://developer.zendesk.com/api-reference/custom-data/custom-objects/custom_object_records/
+func resourceZendeskCustomObjectRecord() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a record of a custom object, identified by its external id. " +
+			"Records are upserted, so applying a record which already exists with the same external id updates it. " +
+			"Import with the id `<custom_object_key>/<external_id>`.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return setCustomObjectRecord(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return readCustomObjectRecord(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return setCustomObjectRecord(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return deleteCustomObjectRecord(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+				objectKey, externalID, err := parseCustomObjectRecordID(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				err = setSchemaFields(d, map[string]interface{}{
+					"custom_object_key": objectKey,
+					"external_id":       externalID,
+				})
+				return []*schema.ResourceData{d}, err
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"custom_object_key": {
+				Description: "The key of the custom object the record belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"external_id": {
+				Description: "The external id of the record, unique within its custom object.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the record. Computed when the custom object numbers its records automatically.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"custom_object_fields": {
+				Description: "The values of the custom object fields, by field key. Values are converted to the type of their field: " +
+					`"true" or "false" for checkbox fields, numbers for integer and decimal fields, ` +
+					"and comma separated option values for multiselect fields. " +
+					"Only the declared fields are managed, the other fields of the record are left as they are.",
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+				// numbers are returned in their canonical form, e.g. "1.50" as 1.5
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					o, err := strconv.ParseFloat(old, 64)
+					if err != nil {
+						return false
+					}
+					n, err := strconv.ParseFloat(new, 64)
+					return err == nil && o == n
+				},
+			},
+			"record_id": {
+				Description: "The id of the record in Zendesk.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"url": {
+				Description: "The API url of the record.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func parseCustomObjectRecordID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("could not parse custom object record id %s: should be <custom_object_key>/<external_id>", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+// customObjectRecordPath returns the path of the records of the custom object with the external id query
+func customObjectRecordPath(d getter, externalIDParam string) string {
+	q := url.Values{}
+	q.Set(externalIDParam, d.Get("external_id").(string))
+	return fmt.Sprintf("/custom_objects/%s/records.json?%s", d.Get("custom_object_key").(string), q.Encode())
+}
+
+// getCustomObjectFieldTypes returns the types of the fields of a custom object by field key
+func getCustomObjectFieldTypes(ctx context.Context, zd client.BaseAPI, objectKey string) (map[string]string, error) {
+	var result struct {
+		CustomObjectFields []customObjectField `json:"custom_object_fields"`
+	}
+	err := getJSON(ctx, zd, fmt.Sprintf("/custom_objects/%s/fields.json", objectKey), &result)
+	if err != nil {
+		return nil, err
+	}
+
+	types := make(map[string]string)
+	for _, f := range result.CustomObjectFields {
+		types[f.Key] = f.Type
+	}
+
+	return types, nil
+}
+
+// customObjectFieldValue converts the configured string value of a field to the type of the field
+func customObjectFieldValue(fieldType, v string) (interface{}, error) {
+	switch fieldType {
+	case "checkbox":
+		return strconv.ParseBool(v)
+	case "integer":
+		return strconv.ParseInt(v, 10, 64)
+	case "decimal":
+		return strconv.ParseFloat(v, 64)
+	case "multiselect":
+		values := make([]string, 0)
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				values = append(values, s)
+			}
+		}
+		return values, nil
+	default:
+		return v, nil
+	}
+}
+
+// customObjectFieldString converts a field value returned by the API to its configured string form
+func customObjectFieldString(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case bool:
+		return strconv.FormatBool(t)
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case []interface{}:
+		values := make([]string, 0, len(t))
+		for _, e := range t {
+			values = append(values, customObjectFieldString(e))
+		}
+		return strings.Join(values, ",")
+	default:
+		return fmt.Sprintf("%v", t)
+	}
+}
+
+func marshalCustomObjectRecord(record customObjectRecord, d identifiableGetterSetter) error {
+	// only the declared fields are managed, the other fields of the record are ignored
+	declared, _ := d.Get("custom_object_fields").(map[string]interface{})
+
+	values := make(map[string]interface{})
+	for k, v := range record.CustomObjectFields {
+		if _, ok := declared[k]; !ok || v == nil {
+			continue
+		}
+		values[k] = customObjectFieldString(v)
+	}
+
+	fields := map[string]interface{}{
+		"name":                 record.Name,
+		"external_id":          record.ExternalID,
+		"custom_object_fields": values,
+		"record_id":            record.ID,
+		"url":                  record.URL,
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+func unmarshalCustomObjectRecord(d identifiableGetterSetter, fieldTypes map[string]string) (customObjectRecord, error) {
+	record := customObjectRecord{
+		CustomObjectFields: make(map[string]interface{}),
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		record.Name = v.(string)
+	}
+
+	if v, ok := d.GetOk("external_id"); ok {
+		record.ExternalID = v.(string)
+	}
+
+	if v, ok := d.GetOk("custom_object_fields"); ok {
+		values := v.(map[string]interface{})
+
+		keys := make([]string, 0, len(values))
+		for k := range values {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			fieldType, ok := fieldTypes[k]
+			if !ok {
+				return record, fmt.Errorf("custom object %s has no field %s", d.Get("custom_object_key"), k)
+			}
+
+			value, err := customObjectFieldValue(fieldType, values[k].(string))
+			if err != nil {
+				return record, fmt.Errorf("invalid value for the %s field %s: %v", fieldType, k, err)
+			}
+			record.CustomObjectFields[k] = value
+		}
+	}
+
+	return record, nil
+}
+
+// setCustomObjectRecord creates or updates the record with the external id
+func setCustomObjectRecord(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	objectKey := d.Get("custom_object_key").(string)
+
+	fieldTypes, err := getCustomObjectFieldTypes(ctx, zd, objectKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	record, err := unmarshalCustomObjectRecord(d, fieldTypes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// fields removed from the configuration are cleared
+	if c, ok := d.(interface {
+		GetChange(string) (interface{}, interface{})
+	}); ok {
+		old, _ := c.GetChange("custom_object_fields")
+		oldValues, _ := old.(map[string]interface{})
+		for k := range oldValues {
+			if _, ok := record.CustomObjectFields[k]; !ok {
+				record.CustomObjectFields[k] = nil
+			}
+		}
+	}
+
+	var data, result struct {
+		CustomObjectRecord customObjectRecord `json:"custom_object_record"`
+	}
+	data.CustomObjectRecord = record
+
+	// upsert by external id
+	err = patchJSON(ctx, zd, customObjectRecordPath(d, "external_id"), data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", objectKey, result.CustomObjectRecord.ExternalID))
+
+	err = marshalCustomObjectRecord(result.CustomObjectRecord, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readCustomObjectRecord(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var result struct {
+		CustomObjectRecords []customObjectRecord `json:"custom_object_records"`
+	}
+	err := getJSON(ctx, zd, customObjectRecordPath(d, "filter[external_ids]"), &result)
+	if isNotFound(err) {
+		// the custom object was deleted
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(result.CustomObjectRecords) == 0 {
+		d.SetId("")
+		return diags
+	}
+
+	err = marshalCustomObjectRecord(result.CustomObjectRecords[0], d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func deleteCustomObjectRecord(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := zd.Delete(ctx, customObjectRecordPath(d, "external_id"))
+	if err != nil && !isNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
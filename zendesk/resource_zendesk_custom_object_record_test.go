@@ -0,0 +1,318 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nukosuke/go-zendesk/zendesk"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+var testCustomObjectFields = []byte(`{"custom_object_fields": [
+	{"key": "serial_number", "type": "text"},
+	{"key": "in_stock", "type": "checkbox"},
+	{"key": "quantity", "type": "integer"},
+	{"key": "price", "type": "decimal"},
+	{"key": "regions", "type": "multiselect"}
+]}`)
+
+func TestUnmarshalCustomObjectRecordConvertsFieldTypes(t *testing.T) {
+	d := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"custom_object_key": "product",
+			"external_id":       "sku-1",
+			"name":              "Widget",
+			"custom_object_fields": map[string]interface{}{
+				"serial_number": "W-1",
+				"in_stock":      "true",
+				"quantity":      "12",
+				"price":         "9.99",
+				"regions":       "emea, apac",
+			},
+		},
+	}
+
+	fieldTypes := map[string]string{
+		"serial_number": "text",
+		"in_stock":      "checkbox",
+		"quantity":      "integer",
+		"price":         "decimal",
+		"regions":       "multiselect",
+	}
+
+	record, err := unmarshalCustomObjectRecord(d, fieldTypes)
+	if err != nil {
+		t.Fatalf("unmarshal returned an error: %v", err)
+	}
+
+	expected := map[string]interface{}{
+		"serial_number": "W-1",
+		"in_stock":      true,
+		"quantity":      int64(12),
+		"price":         9.99,
+		"regions":       []string{"emea", "apac"},
+	}
+	if !reflect.DeepEqual(record.CustomObjectFields, expected) {
+		t.Fatalf("custom object fields were %v. should have been %v", record.CustomObjectFields, expected)
+	}
+}
+
+func TestUnmarshalCustomObjectRecordRejectsInvalidValues(t *testing.T) {
+	cases := map[string]map[string]interface{}{
+		"unknown field":   {"colour": "red"},
+		"invalid integer": {"quantity": "twelve"},
+		"invalid boolean": {"in_stock": "yes please"},
+	}
+
+	for name, values := range cases {
+		d := &identifiableMapGetterSetter{
+			mapGetterSetter: mapGetterSetter{
+				"custom_object_key":    "product",
+				"external_id":          "sku-1",
+				"custom_object_fields": values,
+			},
+		}
+
+		_, err := unmarshalCustomObjectRecord(d, map[string]string{"quantity": "integer", "in_stock": "checkbox"})
+		if err == nil {
+			t.Fatalf("%s: unmarshal should have returned an error", name)
+		}
+	}
+}
+
+func TestMarshalCustomObjectRecord(t *testing.T) {
+	d := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"custom_object_fields": map[string]interface{}{
+				"in_stock": "false",
+				"quantity": "12",
+				"price":    "1.50",
+				"regions":  "emea,apac",
+				"notes":    "",
+			},
+		},
+	}
+	record := customObjectRecord{
+		ID:         "01GDXYD7ZTWYP542BA8MDDTE36",
+		Name:       "Widget",
+		ExternalID: "sku-1",
+		CustomObjectFields: map[string]interface{}{
+			"in_stock": true,
+			"quantity": float64(12),
+			"price":    1.5,
+			"regions":  []interface{}{"emea", "apac"},
+			"notes":    nil,
+			"color":    "blue",
+		},
+	}
+
+	err := marshalCustomObjectRecord(record, d)
+	if err != nil {
+		t.Fatalf("marshal returned an error: %v", err)
+	}
+
+	expected := map[string]interface{}{
+		"in_stock": "true",
+		"quantity": "12",
+		"price":    "1.5",
+		"regions":  "emea,apac",
+	}
+	if v := d.Get("custom_object_fields"); !reflect.DeepEqual(v, expected) {
+		t.Fatalf("custom_object_fields was %v. should have been %v", v, expected)
+	}
+}
+
+func TestSetCustomObjectRecordUpsertsByExternalID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	d := changeGetterSetter{
+		identifiableMapGetterSetter: &identifiableMapGetterSetter{
+			mapGetterSetter: mapGetterSetter{
+				"custom_object_key": "product",
+				"external_id":       "sku 1",
+				"name":              "Widget",
+				"custom_object_fields": map[string]interface{}{
+					"quantity": "12",
+				},
+			},
+		},
+		old: mapGetterSetter{
+			"custom_object_fields": map[string]interface{}{
+				"quantity": "10",
+				"price":    "9.99",
+			},
+		},
+	}
+
+	out := []byte(`{"custom_object_record": {"id": "01GDXYD7ZTWYP542BA8MDDTE36", "name": "Widget", "external_id": "sku 1",
+		"custom_object_fields": {"quantity": 12, "price": null}}}`)
+
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/custom_objects/product/fields.json")).Return(testCustomObjectFields, nil)
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/custom_objects/product/records.json?external_id=sku+1"), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, path string, data interface{}) ([]byte, error) {
+			if method := ctx.Value(methodKey{}); method != http.MethodPatch {
+				t.Fatalf("custom object record was set with %v. should have been PATCH", method)
+			}
+			fields := data.(struct {
+				CustomObjectRecord customObjectRecord `json:"custom_object_record"`
+			}).CustomObjectRecord.CustomObjectFields
+			expected := map[string]interface{}{"quantity": int64(12), "price": nil}
+			if !reflect.DeepEqual(fields, expected) {
+				t.Fatalf("custom object fields were %v. should have been %v", fields, expected)
+			}
+			return out, nil
+		})
+
+	if diags := setCustomObjectRecord(context.Background(), d, m); len(diags) != 0 {
+		t.Fatalf("setCustomObjectRecord returned an error: %v", diags)
+	}
+
+	if v := d.Id(); v != "product/sku 1" {
+		t.Fatalf("setCustomObjectRecord did not set the resource id. Id was %s", v)
+	}
+
+	if v := d.Get("record_id"); v != "01GDXYD7ZTWYP542BA8MDDTE36" {
+		t.Fatalf("setCustomObjectRecord did not set record_id. record_id was %v", v)
+	}
+}
+
+func TestReadCustomObjectRecord(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	d := &identifiableMapGetterSetter{
+		id: "product/sku-1",
+		mapGetterSetter: mapGetterSetter{
+			"custom_object_key":    "product",
+			"external_id":          "sku-1",
+			"custom_object_fields": map[string]interface{}{"in_stock": "true"},
+		},
+	}
+
+	q := url.Values{}
+	q.Set("filter[external_ids]", "sku-1")
+	out := []byte(`{"custom_object_records": [{"id": "01GDXYD7ZTWYP542BA8MDDTE36", "name": "Widget", "external_id": "sku-1",
+		"custom_object_fields": {"in_stock": false, "color": "blue"}}]}`)
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/custom_objects/product/records.json?"+q.Encode())).Return(out, nil)
+
+	if diags := readCustomObjectRecord(context.Background(), d, m); len(diags) != 0 {
+		t.Fatalf("readCustomObjectRecord returned an error: %v", diags)
+	}
+
+	if v := d.Get("name"); v != "Widget" {
+		t.Fatalf("readCustomObjectRecord did not set name. name was %v", v)
+	}
+
+	if v := d.Get("custom_object_fields").(map[string]interface{})["in_stock"]; v != "false" {
+		t.Fatalf("readCustomObjectRecord did not set in_stock. in_stock was %v", v)
+	}
+
+	// fields which are not declared must not show up as a diff
+	if v := d.Get("custom_object_fields").(map[string]interface{}); len(v) != 1 {
+		t.Fatalf("readCustomObjectRecord should only set the declared fields. fields were %v", v)
+	}
+}
+
+func TestReadCustomObjectRecordRemoved(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	d := &identifiableMapGetterSetter{
+		id: "product/sku-1",
+		mapGetterSetter: mapGetterSetter{
+			"custom_object_key": "product",
+			"external_id":       "sku-1",
+		},
+	}
+
+	m.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte(`{"custom_object_records": []}`), nil)
+
+	if diags := readCustomObjectRecord(context.Background(), d, m); len(diags) != 0 {
+		t.Fatalf("readCustomObjectRecord returned an error: %v", diags)
+	}
+
+	if v := d.Id(); v != "" {
+		t.Fatalf("readCustomObjectRecord did not remove the deleted record from the state. Id was %s", v)
+	}
+}
+
+func TestDeleteCustomObjectRecord(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	d := &identifiableMapGetterSetter{
+		id: "product/sku-1",
+		mapGetterSetter: mapGetterSetter{
+			"custom_object_key": "product",
+			"external_id":       "sku-1",
+		},
+	}
+
+	m.EXPECT().Delete(gomock.Any(), gomock.Eq("/custom_objects/product/records.json?external_id=sku-1")).Return(nil)
+	if diags := deleteCustomObjectRecord(context.Background(), d, m); len(diags) != 0 {
+		t.Fatalf("deleteCustomObjectRecord returned an error: %v", diags)
+	}
+}
+
+func testCustomObjectRecordDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(zendesk.BaseAPI)
+
+	for k, r := range s.RootModule().Resources {
+		if r.Type != "zendesk_custom_object_record" {
+			continue
+		}
+
+		d := &identifiableMapGetterSetter{
+			id: r.Primary.ID,
+			mapGetterSetter: mapGetterSetter{
+				"custom_object_key": r.Primary.Attributes["custom_object_key"],
+				"external_id":       r.Primary.Attributes["external_id"],
+			},
+		}
+		if diags := readCustomObjectRecord(context.Background(), d, client); diags.HasError() {
+			return fmt.Errorf("could not check the destroyed custom object record named %s: %v", k, diags)
+		}
+
+		if d.Id() != "" {
+			return fmt.Errorf("custom object record named %s still exists after destroy", k)
+		}
+	}
+
+	return nil
+}
+
+func TestAccCustomObjectRecordExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCustomObjectRecordDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: concatExampleConfig(t,
+					readExampleConfig(t, "resources/zendesk_custom_object/resource.tf"),
+					readExampleConfig(t, "resources/zendesk_custom_object_field/resource.tf"),
+					readExampleConfig(t, "resources/zendesk_custom_object_record/resource.tf"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zendesk_custom_object_record.asset-t800", "name", "T-800 #0001"),
+					resource.TestCheckResourceAttr("zendesk_custom_object_record.asset-t800", "custom_object_fields.model", "t800"),
+					resource.TestCheckResourceAttrSet("zendesk_custom_object_record.asset-t800", "record_id"),
+				),
+			},
+		},
+	})
+}
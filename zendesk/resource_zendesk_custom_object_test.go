@@ -0,0 +1,183 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nukosuke/go-zendesk/zendesk"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestUnmarshalCustomObject(t *testing.T) {
+	m := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"key":                  "asset",
+			"title":                "Asset",
+			"title_pluralized":     "Assets",
+			"include_in_list_view": true,
+		},
+	}
+
+	object := unmarshalCustomObject(m)
+
+	expected := customObject{
+		Key:               "asset",
+		Title:             "Asset",
+		TitlePluralized:   "Assets",
+		IncludeInListView: true,
+	}
+	if object != expected {
+		t.Fatalf("custom object was %v. should have been %v", object, expected)
+	}
+}
+
+func TestCreateCustomObject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	out := []byte(`{"custom_object": {"key": "asset", "title": "Asset", "title_pluralized": "Assets"}}`)
+
+	m.EXPECT().Post(gomock.Any(), gomock.Eq("/custom_objects.json"), gomock.Any()).Return(out, nil)
+	if diags := createCustomObject(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createCustomObject returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "asset" {
+		t.Fatalf("createCustomObject did not set the key as resource id. Id was %s", v)
+	}
+
+	if v := i.Get("title_pluralized"); v != "Assets" {
+		t.Fatalf("createCustomObject did not set title_pluralized. title_pluralized was %v", v)
+	}
+}
+
+func TestReadCustomObject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("asset")
+
+	out := []byte(`{"custom_object": {"key": "asset", "title": "Asset", "title_pluralized": "Assets", "include_in_list_view": true}}`)
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/custom_objects/asset.json")).Return(out, nil)
+	if diags := readCustomObject(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readCustomObject returned an error: %v", diags)
+	}
+
+	if v := i.Get("include_in_list_view"); v != true {
+		t.Fatalf("readCustomObject did not set include_in_list_view. include_in_list_view was %v", v)
+	}
+}
+
+func TestReadCustomObjectRemoved(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("asset")
+
+	notFound := zendesk.NewError(nil, &http.Response{StatusCode: http.StatusNotFound})
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/custom_objects/asset.json")).Return(nil, notFound)
+	if diags := readCustomObject(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readCustomObject returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "" {
+		t.Fatalf("readCustomObject did not remove the deleted custom object from the state. Id was %s", v)
+	}
+}
+
+func TestUpdateCustomObjectPatches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "asset",
+		mapGetterSetter: mapGetterSetter{
+			"key":              "asset",
+			"title":            "Device",
+			"title_pluralized": "Devices",
+		},
+	}
+
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/custom_objects/asset.json"), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, path string, data interface{}) ([]byte, error) {
+			if method := ctx.Value(methodKey{}); method != http.MethodPatch {
+				t.Fatalf("custom object was updated with %v. should have been PATCH", method)
+			}
+			if key := data.(struct {
+				CustomObject customObject `json:"custom_object"`
+			}).CustomObject.Key; key != "" {
+				t.Fatalf("update sent the key %s, which can't be changed", key)
+			}
+			return []byte(`{"custom_object": {"key": "asset", "title": "Device", "title_pluralized": "Devices"}}`), nil
+		})
+	if diags := updateCustomObject(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateCustomObject returned an error: %v", diags)
+	}
+}
+
+func TestDeleteCustomObject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("asset")
+
+	m.EXPECT().Delete(gomock.Any(), gomock.Eq("/custom_objects/asset.json")).Return(nil)
+	if diags := deleteCustomObject(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteCustomObject returned an error: %v", diags)
+	}
+}
+
+func testCustomObjectDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(zendesk.BaseAPI)
+
+	for k, r := range s.RootModule().Resources {
+		if r.Type != "zendesk_custom_object" {
+			continue
+		}
+
+		_, err := client.Get(context.Background(), fmt.Sprintf("/custom_objects/%s.json", r.Primary.ID))
+		if err == nil {
+			return fmt.Errorf("did not get error from zendesk when trying to fetch the destroyed custom object named %s", k)
+		}
+
+		if !isNotFound(err) {
+			return fmt.Errorf("did not get a not found error after destroy. error was %v", err)
+		}
+	}
+
+	return nil
+}
+
+func TestAccCustomObjectExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCustomObjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: readExampleConfig(t, "resources/zendesk_custom_object/resource.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zendesk_custom_object.asset", "title", "Asset"),
+					resource.TestCheckResourceAttr("zendesk_custom_object.asset", "include_in_list_view", "true"),
+					resource.TestCheckResourceAttr("data.zendesk_custom_object.asset", "title_pluralized", "Assets"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,266 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// customTicketStatus is the custom ticket status JSON payload format
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/custom_ticket_statuses/#json-format
+type customTicketStatus struct {
+	ID                    int64      `json:"id,omitempty"`
+	StatusCategory        string     `json:"status_category,omitempty"`
+	AgentLabel            string     `json:"agent_label"`
+	RawAgentLabel         string     `json:"raw_agent_label,omitempty"`
+	EndUserLabel          string     `json:"end_user_label,omitempty"`
+	RawEndUserLabel       string     `json:"raw_end_user_label,omitempty"`
+	Description           string     `json:"description"`
+	RawDescription        string     `json:"raw_description,omitempty"`
+	EndUserDescription    string     `json:"end_user_description"`
+	RawEndUserDescription string     `json:"raw_end_user_description,omitempty"`
+	Active                bool       `json:"active"`
+	Default               bool       `json:"default,omitempty"`
+	CreatedAt             *time.Time `json:"created_at,omitempty"`
+	UpdatedAt             *time.Time `json:"updated_at,omitempty"`
+}
+
+// Status categories a custom ticket status can belong to
+var customTicketStatusCategories = []string{
+	"new",
+	"open",
+	"pending",
+	"hold",
+	"solved",
+}
+
+// https://developer.zendesk.com/api-reference/ticketing/tickets/custom_ticket_statuses/
+func resourceZendeskCustomTicketStatus() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a custom ticket status resource. Custom ticket statuses cannot be deleted, so destroying this resource deactivates the status.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return createCustomTicketStatus(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return readCustomTicketStatus(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return updateCustomTicketStatus(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return deleteCustomTicketStatus(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"status_category": {
+				Description:  `The status category the custom ticket status belongs to. Allowed values are "new", "open", "pending", "hold", or "solved".`,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(customTicketStatusCategories, false),
+			},
+			"agent_label": {
+				Description: "The label displayed to agents.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"end_user_label": {
+				Description: "The label displayed to end users. Defaults to the label of the status category.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": {
+				Description: "The description of when the status should be used, displayed to agents.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
+			"end_user_description": {
+				Description: "The description of the status, displayed to end users.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
+			"active": {
+				Description: "Whether the custom ticket status is available to agents.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"default": {
+				Description: "Whether the custom ticket status is the default status of its status category.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func marshalCustomTicketStatus(status customTicketStatus, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"status_category":      status.StatusCategory,
+		"agent_label":          status.AgentLabel,
+		"end_user_label":       status.EndUserLabel,
+		"description":          status.Description,
+		"end_user_description": status.EndUserDescription,
+		"active":               status.Active,
+		"default":              status.Default,
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+func unmarshalCustomTicketStatus(d identifiableGetterSetter) (customTicketStatus, error) {
+	status := customTicketStatus{}
+
+	if v := d.Id(); v != "" {
+		id, err := atoi64(v)
+		if err != nil {
+			return status, fmt.Errorf("could not parse custom ticket status id %s: %v", v, err)
+		}
+		status.ID = id
+	}
+
+	if v, ok := d.GetOk("status_category"); ok {
+		status.StatusCategory = v.(string)
+	}
+
+	if v, ok := d.GetOk("agent_label"); ok {
+		status.AgentLabel = v.(string)
+	}
+
+	if v, ok := d.GetOk("end_user_label"); ok {
+		status.EndUserLabel = v.(string)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		status.Description = v.(string)
+	}
+
+	if v, ok := d.GetOk("end_user_description"); ok {
+		status.EndUserDescription = v.(string)
+	}
+
+	if v, ok := d.GetOk("active"); ok {
+		status.Active = v.(bool)
+	}
+
+	return status, nil
+}
+
+func createCustomTicketStatus(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	status, err := unmarshalCustomTicketStatus(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var data, result struct {
+		CustomStatus customTicketStatus `json:"custom_status"`
+	}
+	data.CustomStatus = status
+
+	err = postJSON(ctx, zd, "/custom_statuses.json", data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", result.CustomStatus.ID))
+
+	err = marshalCustomTicketStatus(result.CustomStatus, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readCustomTicketStatus(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result struct {
+		CustomStatus customTicketStatus `json:"custom_status"`
+	}
+	err = getJSON(ctx, zd, fmt.Sprintf("/custom_statuses/%d.json", id), &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalCustomTicketStatus(result.CustomStatus, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateCustomTicketStatus(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	status, err := unmarshalCustomTicketStatus(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var data, result struct {
+		CustomStatus customTicketStatus `json:"custom_status"`
+	}
+	data.CustomStatus = status
+	// status_category can't be changed after creation
+	data.CustomStatus.StatusCategory = ""
+
+	err = putJSON(ctx, zd, fmt.Sprintf("/custom_statuses/%d.json", status.ID), data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalCustomTicketStatus(result.CustomStatus, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// Custom ticket statuses can't be deleted, so they are deactivated instead
+func deleteCustomTicketStatus(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := map[string]interface{}{
+		"custom_status": map[string]interface{}{
+			"active": false,
+		},
+	}
+
+	err = putJSON(ctx, zd, fmt.Sprintf("/custom_statuses/%d.json", id), data, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
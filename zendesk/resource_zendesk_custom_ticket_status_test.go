@@ -0,0 +1,191 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nukosuke/go-zendesk/zendesk"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestMarshalCustomTicketStatus(t *testing.T) {
+	expected := customTicketStatus{
+		StatusCategory: "open",
+		AgentLabel:     "Awaiting engineering",
+		EndUserLabel:   "In progress",
+		Active:         true,
+	}
+	m := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{},
+	}
+
+	err := marshalCustomTicketStatus(expected, m)
+	if err != nil {
+		t.Fatalf("Failed to marshal map %v", err)
+	}
+
+	v, ok := m.GetOk("agent_label")
+	if !ok {
+		t.Fatal("Failed to get agent_label value")
+	}
+	if v != expected.AgentLabel {
+		t.Fatalf("custom ticket status had incorrect agent_label value %v. should have been %v", v, expected.AgentLabel)
+	}
+
+	v, ok = m.GetOk("status_category")
+	if !ok {
+		t.Fatal("Failed to get status_category value")
+	}
+	if v != expected.StatusCategory {
+		t.Fatalf("custom ticket status had incorrect status_category value %v. should have been %v", v, expected.StatusCategory)
+	}
+}
+
+func TestUnmarshalCustomTicketStatus(t *testing.T) {
+	m := &identifiableMapGetterSetter{
+		id: "100",
+		mapGetterSetter: mapGetterSetter{
+			"status_category": "open",
+			"agent_label":     "Awaiting engineering",
+			"active":          true,
+		},
+	}
+
+	status, err := unmarshalCustomTicketStatus(m)
+	if err != nil {
+		t.Fatalf("unmarshal returned an error: %v", err)
+	}
+
+	if status.ID != 100 {
+		t.Fatalf("custom ticket status had id value %v. should have been 100", status.ID)
+	}
+
+	if v := m.Get("agent_label"); status.AgentLabel != v {
+		t.Fatalf("custom ticket status had agent_label value %v. should have been %v", status.AgentLabel, v)
+	}
+}
+
+func TestCreateCustomTicketStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	out := []byte(`{"custom_status": {"id": 12345, "status_category": "open", "agent_label": "Awaiting engineering", "active": true}}`)
+
+	m.EXPECT().Post(gomock.Any(), gomock.Eq("/custom_statuses.json"), gomock.Any()).Return(out, nil)
+	if diags := createCustomTicketStatus(context.Background(), i, m); len(diags) != 0 {
+		t.Fatal("createCustomTicketStatus return an error")
+	}
+
+	if v := i.Id(); v != "12345" {
+		t.Fatalf("createCustomTicketStatus did not set resource id. Id was %s", v)
+	}
+
+	if v := i.Get("agent_label"); v != "Awaiting engineering" {
+		t.Fatalf("createCustomTicketStatus did not set resource agent_label. agent_label was %s", v)
+	}
+}
+
+func TestReadCustomTicketStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("12345")
+
+	out := []byte(`{"custom_status": {"id": 12345, "status_category": "open", "agent_label": "Awaiting engineering", "active": true}}`)
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/custom_statuses/12345.json")).Return(out, nil)
+	if diags := readCustomTicketStatus(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readCustomTicketStatus received an error when calling: %v", diags)
+	}
+
+	if v := i.Get("active"); v != true {
+		t.Fatalf("readCustomTicketStatus did not set resource active. active was %v", v)
+	}
+}
+
+func TestUpdateCustomTicketStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id:              "12345",
+		mapGetterSetter: mapGetterSetter{},
+	}
+
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/custom_statuses/12345.json"), gomock.Any()).Return([]byte(`{"custom_status": {}}`), nil)
+	if diags := updateCustomTicketStatus(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateCustomTicketStatus returned an error %v", diags)
+	}
+}
+
+func TestDeleteCustomTicketStatusDeactivates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c := mock.NewClient(ctrl)
+	d := newIdentifiableGetterSetter()
+
+	d.SetId("1234")
+
+	expected := map[string]interface{}{
+		"custom_status": map[string]interface{}{
+			"active": false,
+		},
+	}
+	c.EXPECT().Put(gomock.Any(), gomock.Eq("/custom_statuses/1234.json"), gomock.Eq(expected)).Return([]byte(`{}`), nil)
+	diags := deleteCustomTicketStatus(context.Background(), d, c)
+	if len(diags) != 0 {
+		t.Fatalf("Got error from resource delete: %v", diags)
+	}
+}
+
+func testCustomTicketStatusDeactivated(s *terraform.State) error {
+	client := testAccProvider.Meta().(zendesk.BaseAPI)
+
+	for k, r := range s.RootModule().Resources {
+		if r.Type != "zendesk_custom_ticket_status" {
+			continue
+		}
+
+		var result struct {
+			CustomStatus customTicketStatus `json:"custom_status"`
+		}
+		err := getJSON(context.Background(), client, fmt.Sprintf("/custom_statuses/%s.json", r.Primary.ID), &result)
+		if err != nil {
+			return err
+		}
+
+		if result.CustomStatus.Active {
+			return fmt.Errorf("custom ticket status is still active after destroy. resource name %s", k)
+		}
+	}
+	return nil
+}
+
+func TestAccCustomTicketStatusExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCustomTicketStatusDeactivated,
+		Steps: []resource.TestStep{
+			{
+				Config: readExampleConfig(t, "resources/zendesk_custom_ticket_status/resource.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zendesk_custom_ticket_status.awaiting-engineering", "agent_label", "Awaiting engineering"),
+					resource.TestCheckResourceAttr("zendesk_custom_ticket_status.awaiting-engineering", "active", "true"),
+					resource.TestCheckResourceAttr("data.zendesk_custom_ticket_status.open", "default", "true"),
+				),
+			},
+		},
+	})
+}
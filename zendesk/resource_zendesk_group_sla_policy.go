@@ -0,0 +1,357 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// groupSLAPolicyFilter is a group SLA policy condition
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/group_sla_policies/#filter
+type groupSLAPolicyFilter struct {
+	Field    string  `json:"field"`
+	Operator string  `json:"operator"`
+	Value    []int64 `json:"value"`
+}
+
+type groupSLAPolicyMetric struct {
+	Priority      string `json:"priority"`
+	Metric        string `json:"metric"`
+	Target        int    `json:"target"`
+	BusinessHours bool   `json:"business_hours"`
+}
+
+// groupSLAPolicy is the group SLA policy JSON payload format
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/group_sla_policies/#json-format
+type groupSLAPolicy struct {
+	ID          int64  `json:"id,omitempty"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Position    int64  `json:"position,omitempty"`
+	Filter      struct {
+		All []groupSLAPolicyFilter `json:"all"`
+	} `json:"filter"`
+	PolicyMetrics []groupSLAPolicyMetric `json:"policy_metrics,omitempty"`
+	CreatedAt     *time.Time             `json:"created_at,omitempty"`
+	UpdatedAt     *time.Time             `json:"updated_at,omitempty"`
+}
+
+// https://developer.zendesk.com/api-reference/ticketing/business-rules/group_sla_policies/
+func resourceZendeskGroupSLAPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a group SLA policy resource.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return createGroupSLAPolicy(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return readGroupSLAPolicy(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return updateGroupSLAPolicy(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return deleteGroupSLAPolicy(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"title": {
+				Description: "The title of the group SLA policy.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The description of the group SLA policy.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
+			"position": {
+				Description: "Position of the group SLA policy that determines the order they will be matched. If not specified, the group SLA policy is added as the last position.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"all": {
+				Description: "Logical AND. Tickets must fulfill all of the conditions to be considered matching.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field": {
+							Description: `The name of a ticket field. Only "group_id" is supported.`,
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "group_id",
+							ValidateFunc: validation.StringInSlice([]string{
+								"group_id",
+							}, false),
+						},
+						"operator": {
+							Description: `A comparison operator. Only "includes" is supported.`,
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "includes",
+							ValidateFunc: validation.StringInSlice([]string{
+								"includes",
+							}, false),
+						},
+						"value": {
+							Description: "The ids of the groups whose ownership time is measured.",
+							Type:        schema.TypeSet,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+							Required: true,
+						},
+					},
+				},
+				Required: true,
+			},
+			"policy_metrics": {
+				Description: "The metric targets for each value of the priority field.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"priority": {
+							Description:  `Priority that a ticket must match. Allowed values are "low", "normal", "high", or "urgent".`,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(slaPolicyPriorities, false),
+						},
+						"metric": {
+							Description: "The definition of the time that is being measured.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "group_ownership_time",
+							ValidateFunc: validation.StringInSlice([]string{
+								"group_ownership_time",
+							}, false),
+						},
+						"target": {
+							Description: "The time within which a group should hand the ticket over, in minutes.",
+							Type:        schema.TypeInt,
+							Required:    true,
+						},
+						"business_hours": {
+							Description: "Whether the metric targets are being measured in business hours or calendar hours.",
+							Type:        schema.TypeBool,
+							Default:     false,
+							Optional:    true,
+						},
+					},
+				},
+				Required: true,
+			},
+		},
+	}
+}
+
+// Marshal the group SLA policy object to the terraform schema
+func marshalGroupSLAPolicy(policy groupSLAPolicy, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"title":       policy.Title,
+		"description": policy.Description,
+		"position":    policy.Position,
+	}
+
+	var alls []map[string]interface{}
+	for _, v := range policy.Filter.All {
+		m := map[string]interface{}{
+			"field":    v.Field,
+			"operator": v.Operator,
+			"value":    v.Value,
+		}
+		alls = append(alls, m)
+	}
+	fields["all"] = alls
+
+	var metrics []map[string]interface{}
+	for _, v := range policy.PolicyMetrics {
+		m := map[string]interface{}{
+			"priority":       v.Priority,
+			"metric":         v.Metric,
+			"target":         v.Target,
+			"business_hours": v.BusinessHours,
+		}
+		metrics = append(metrics, m)
+	}
+	fields["policy_metrics"] = metrics
+
+	return setSchemaFields(d, fields)
+}
+
+// Unmarshal the terraform schema to the group SLA policy object
+func unmarshalGroupSLAPolicy(d identifiableGetterSetter) (groupSLAPolicy, error) {
+	policy := groupSLAPolicy{}
+
+	if v := d.Id(); v != "" {
+		id, err := atoi64(v)
+		if err != nil {
+			return policy, fmt.Errorf("could not parse group SLA policy id %s: %v", v, err)
+		}
+		policy.ID = id
+	}
+
+	if v, ok := d.GetOk("title"); ok {
+		policy.Title = v.(string)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		policy.Description = v.(string)
+	}
+
+	if v, ok := d.GetOk("all"); ok {
+		allFilters := v.(*schema.Set).List()
+		filters := []groupSLAPolicyFilter{}
+		for _, c := range allFilters {
+			condition, ok := c.(map[string]interface{})
+			if !ok {
+				return policy, fmt.Errorf("could not parse 'all' filters for group SLA policy %v", policy)
+			}
+
+			var groupIDs []int64
+			for _, id := range condition["value"].(*schema.Set).List() {
+				groupIDs = append(groupIDs, int64(id.(int)))
+			}
+
+			filters = append(filters, groupSLAPolicyFilter{
+				Field:    condition["field"].(string),
+				Operator: condition["operator"].(string),
+				Value:    groupIDs,
+			})
+		}
+		policy.Filter.All = filters
+	}
+
+	if v, ok := d.GetOk("policy_metrics"); ok {
+		policyMetrics := v.(*schema.Set).List()
+		metrics := []groupSLAPolicyMetric{}
+		for _, a := range policyMetrics {
+			metric, ok := a.(map[string]interface{})
+			if !ok {
+				return policy, fmt.Errorf("could not parse metrics for group SLA policy %v", policy)
+			}
+
+			metrics = append(metrics, groupSLAPolicyMetric{
+				Priority:      metric["priority"].(string),
+				Metric:        metric["metric"].(string),
+				Target:        metric["target"].(int),
+				BusinessHours: metric["business_hours"].(bool),
+			})
+		}
+		policy.PolicyMetrics = metrics
+	}
+
+	return policy, nil
+}
+
+func createGroupSLAPolicy(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	policy, err := unmarshalGroupSLAPolicy(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var data, result struct {
+		GroupSLAPolicy groupSLAPolicy `json:"group_sla_policy"`
+	}
+	data.GroupSLAPolicy = policy
+
+	err = postJSON(ctx, zd, "/group_slas/policies.json", data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", result.GroupSLAPolicy.ID))
+
+	err = marshalGroupSLAPolicy(result.GroupSLAPolicy, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readGroupSLAPolicy(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result struct {
+		GroupSLAPolicy groupSLAPolicy `json:"group_sla_policy"`
+	}
+	err = getJSON(ctx, zd, fmt.Sprintf("/group_slas/policies/%d.json", id), &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalGroupSLAPolicy(result.GroupSLAPolicy, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateGroupSLAPolicy(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	policy, err := unmarshalGroupSLAPolicy(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var data, result struct {
+		GroupSLAPolicy groupSLAPolicy `json:"group_sla_policy"`
+	}
+	data.GroupSLAPolicy = policy
+
+	err = putJSON(ctx, zd, fmt.Sprintf("/group_slas/policies/%d.json", id), data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalGroupSLAPolicy(result.GroupSLAPolicy, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func deleteGroupSLAPolicy(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = zd.Delete(ctx, fmt.Sprintf("/group_slas/policies/%d.json", id))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,220 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nukosuke/go-zendesk/zendesk"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestMarshalGroupSLAPolicy(t *testing.T) {
+	expected := groupSLAPolicy{
+		Title:       "title",
+		Description: "blabla",
+		PolicyMetrics: []groupSLAPolicyMetric{
+			{
+				Priority: "urgent",
+				Metric:   "group_ownership_time",
+				Target:   60,
+			},
+		},
+	}
+	m := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{},
+	}
+
+	err := marshalGroupSLAPolicy(expected, m)
+	if err != nil {
+		t.Fatalf("Failed to marshal map %v", err)
+	}
+
+	v, ok := m.GetOk("title")
+	if !ok {
+		t.Fatal("Failed to get title value")
+	}
+	if v != expected.Title {
+		t.Fatalf("group sla policy had incorrect title value %v. should have been %v", v, expected.Title)
+	}
+
+	v, ok = m.GetOk("description")
+	if !ok {
+		t.Fatal("Failed to get description value")
+	}
+	if v != expected.Description {
+		t.Fatalf("group sla policy had incorrect description value %v. should have been %v", v, expected.Description)
+	}
+
+	v, ok = m.GetOk("policy_metrics")
+	if !ok {
+		t.Fatal("Failed to get policy_metrics value")
+	}
+	if metrics := v.([]map[string]interface{}); len(metrics) != 1 || metrics[0]["target"] != 60 {
+		t.Fatalf("group sla policy had incorrect policy_metrics value %v", v)
+	}
+}
+
+func TestUnmarshalGroupSLAPolicy(t *testing.T) {
+	s := resourceZendeskGroupSLAPolicy().Schema["all"]
+	all := schema.NewSet(schema.HashResource(s.Elem.(*schema.Resource)), []interface{}{
+		map[string]interface{}{
+			"field":    "group_id",
+			"operator": "includes",
+			"value":    schema.NewSet(schema.HashInt, []interface{}{1, 2}),
+		},
+	})
+
+	m := &identifiableMapGetterSetter{
+		id: "100",
+		mapGetterSetter: mapGetterSetter{
+			"title":       "Tier 1",
+			"description": "escalate to tier 2 within an hour",
+			"all":         all,
+		},
+	}
+
+	policy, err := unmarshalGroupSLAPolicy(m)
+	if err != nil {
+		t.Fatalf("unmarshal returned an error: %v", err)
+	}
+
+	if v := m.Get("title"); policy.Title != v {
+		t.Fatalf("group sla policy had title value %v. should have been %v", policy.Title, v)
+	}
+
+	if policy.ID != 100 {
+		t.Fatalf("group sla policy had id value %v. should have been 100", policy.ID)
+	}
+
+	if len(policy.Filter.All) != 1 || len(policy.Filter.All[0].Value) != 2 {
+		t.Fatalf("group sla policy had incorrect filter %v", policy.Filter.All)
+	}
+}
+
+func TestCreateGroupSLAPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	out := []byte(`{"group_sla_policy": {"id": 12345, "title": "group sla policy"}}`)
+
+	m.EXPECT().Post(gomock.Any(), gomock.Eq("/group_slas/policies.json"), gomock.Any()).Return(out, nil)
+	if diags := createGroupSLAPolicy(context.Background(), i, m); len(diags) != 0 {
+		t.Fatal("createGroupSLAPolicy return an error")
+	}
+
+	if v := i.Id(); v != "12345" {
+		t.Fatalf("createGroupSLAPolicy did not set resource id. Id was %s", v)
+	}
+
+	if v := i.Get("title"); v != "group sla policy" {
+		t.Fatalf("createGroupSLAPolicy did not set resource title. title was %s", v)
+	}
+}
+
+func TestReadGroupSLAPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("12345")
+
+	expected := []byte(`{"group_sla_policy": {"id": 12345, "title": "group sla policy", "filter": {"all": [{"field": "group_id", "operator": "includes", "value": [1, 2]}]}}}`)
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/group_slas/policies/12345.json")).Return(expected, nil)
+	if diags := readGroupSLAPolicy(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readGroupSLAPolicy received an error when calling: %v", diags)
+	}
+
+	if v := i.Get("title"); v != "group sla policy" {
+		t.Fatalf("readGroupSLAPolicy did not set resource title. title was %s", v)
+	}
+}
+
+func TestUpdateGroupSLAPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id:              "12345",
+		mapGetterSetter: mapGetterSetter{},
+	}
+
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/group_slas/policies/12345.json"), gomock.Any()).Return([]byte(`{"group_sla_policy": {}}`), nil)
+	if diags := updateGroupSLAPolicy(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateGroupSLAPolicy returned an error %v", diags)
+	}
+}
+
+func TestDeleteGroupSLAPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c := mock.NewClient(ctrl)
+	d := newIdentifiableGetterSetter()
+
+	d.SetId("1234")
+
+	c.EXPECT().Delete(gomock.Any(), gomock.Eq("/group_slas/policies/1234.json")).Return(nil)
+	diags := deleteGroupSLAPolicy(context.Background(), d, c)
+	if len(diags) != 0 {
+		t.Fatalf("Got error from resource delete: %v", diags)
+	}
+}
+
+func testGroupSLAPolicyDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(zendesk.BaseAPI)
+
+	for k, r := range s.RootModule().Resources {
+		if r.Type != "zendesk_group_sla_policy" {
+			continue
+		}
+
+		ctx := context.Background()
+		_, err := client.Get(ctx, fmt.Sprintf("/group_slas/policies/%s.json", r.Primary.ID))
+		if err == nil {
+			return fmt.Errorf("did not get error from zendesk when trying to fetch the destroyed group sla policy. resource name %s", k)
+		}
+
+		zdresp, ok := err.(zendesk.Error)
+		if !ok {
+			return fmt.Errorf("error %v cannot be asserted as a zendesk error", err)
+		}
+
+		if zdresp.Status() != http.StatusNotFound {
+			return fmt.Errorf("did not get a not found error after destroy. error was %v", zdresp)
+		}
+	}
+	return nil
+}
+
+func TestAccGroupSLAPolicyExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testGroupSLAPolicyDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: concatExampleConfig(t,
+					readExampleConfig(t, "resources/zendesk_group/resource.tf"),
+					readExampleConfig(t, "resources/zendesk_group_sla_policy/resource.tf"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zendesk_group_sla_policy.tier_one", "title", "Tier 1 ownership"),
+					resource.TestCheckResourceAttr("zendesk_group_sla_policy.tier_one", "policy_metrics.#", "2"),
+					resource.TestCheckResourceAttrSet("zendesk_group_sla_policy.tier_one", "all.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,474 @@
+package zendesk
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+	"github.com/yuin/goldmark"
+)
+
+var helpCenterArticleBodySources = []string{"body", "body_file_path"}
+
+// helpCenterArticle is the Help Center article JSON payload format
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/#json-format
+type helpCenterArticle struct {
+	ID                int64    `json:"id,omitempty"`
+	URL               string   `json:"url,omitempty"`
+	HTMLURL           string   `json:"html_url,omitempty"`
+	SectionID         int64    `json:"section_id,omitempty"`
+	Title             string   `json:"title,omitempty"`
+	Body              string   `json:"body"`
+	Locale            string   `json:"locale,omitempty"`
+	SourceLocale      string   `json:"source_locale,omitempty"`
+	AuthorID          int64    `json:"author_id,omitempty"`
+	PermissionGroupID int64    `json:"permission_group_id,omitempty"`
+	UserSegmentID     *int64   `json:"user_segment_id"`
+	Draft             bool     `json:"draft"`
+	Promoted          bool     `json:"promoted"`
+	Position          int64    `json:"position,omitempty"`
+	CommentsDisabled  bool     `json:"comments_disabled"`
+	LabelNames        []string `json:"label_names"`
+}
+
+// https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/
+func resourceZendeskHelpCenterArticle() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Help Center article resource. The body can be read from an HTML or Markdown file.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return createHelpCenterArticle(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return readHelpCenterArticle(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return updateHelpCenterArticle(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return deleteHelpCenterArticle(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customizeHelpCenterArticleDiff,
+
+		Schema: map[string]*schema.Schema{
+			"section_id": {
+				Description: "The id of the section the article belongs to.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"title": {
+				Description: "The title of the article in its locale.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"body": {
+				Description:  "The HTML body of the article in its locale.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: helpCenterArticleBodySources,
+			},
+			"body_file_path": {
+				Description:  "Path to a file with the body of the article. Files with the extension `.md` or `.markdown` are rendered from Markdown to HTML, other files are used as HTML.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: helpCenterArticleBodySources,
+			},
+			"body_file_hash": {
+				Description: "SHA256 hash of the body file, computed by the provider. The body is updated when it changes.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"locale": helpCenterLocaleSchema("The locale the article is created and managed in."),
+			"permission_group_id": {
+				Description: "The id of the permission group defining who can edit and publish the article.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"user_segment_id": {
+				Description: "The id of the user segment defining who can see the article. Visible to everybody when unset.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"author_id": {
+				Description: "The id of the user shown as the author of the article. Defaults to the authenticated user.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"draft": {
+				Description: "Whether the article is a draft in its locale.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"promoted": {
+				Description: "Whether the article is promoted.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"comments_disabled": {
+				Description: "Whether comments are disabled on the article.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"label_names": {
+				Description: "Labels used to find the article, e.g. by the Answer Bot.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+			},
+			"position": {
+				Description: "The position of the article relative to the other articles of its section.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"source_locale": {
+				Description: "The source (default) locale of the article.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"html_url": {
+				Description: "The url of the article in Help Center.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"url": {
+				Description: "The API url of the article.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// customizeHelpCenterArticleDiff plans the hash of the body file, so that changes to the file update the article
+func customizeHelpCenterArticleDiff(ctx context.Context, d *schema.ResourceDiff, i interface{}) error {
+	if config := d.GetRawConfig(); !config.IsNull() && !config.GetAttr("body_file_hash").IsNull() {
+		return nil
+	}
+
+	if !d.NewValueKnown("body_file_path") {
+		return setNewComputedHelpCenterArticleBody(d)
+	}
+
+	path, ok := d.GetOk("body_file_path")
+	if !ok {
+		return nil
+	}
+
+	hash, err := fileHash(path.(string))
+	if errors.Is(err, fs.ErrNotExist) {
+		return setNewComputedHelpCenterArticleBody(d)
+	}
+	if err != nil {
+		return err
+	}
+
+	if d.Get("body_file_hash").(string) == hash {
+		return nil
+	}
+
+	if err := d.SetNew("body_file_hash", hash); err != nil {
+		return err
+	}
+	return d.SetNewComputed("body")
+}
+
+func setNewComputedHelpCenterArticleBody(d *schema.ResourceDiff) error {
+	if err := d.SetNewComputed("body_file_hash"); err != nil {
+		return err
+	}
+	return d.SetNewComputed("body")
+}
+
+func fileHash(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:]), nil
+}
+
+// readHelpCenterArticleBody returns the HTML body from the body file, rendering Markdown files
+func readHelpCenterArticleBody(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".md", ".markdown":
+		var buf bytes.Buffer
+		if err := goldmark.Convert(b, &buf); err != nil {
+			return "", fmt.Errorf("could not render %s: %v", path, err)
+		}
+		return buf.String(), nil
+	default:
+		return string(b), nil
+	}
+}
+
+// setHelpCenterArticleBodyFileHash sets the hash of a body file which didn't exist at plan time
+func setHelpCenterArticleBodyFileHash(d identifiableGetterSetter) error {
+	v, ok := d.GetOk("body_file_path")
+	if hash, _ := d.Get("body_file_hash").(string); !ok || hash != "" {
+		return nil
+	}
+
+	hash, err := fileHash(v.(string))
+	if err != nil {
+		return err
+	}
+
+	return d.Set("body_file_hash", hash)
+}
+
+func marshalHelpCenterArticle(article helpCenterArticle, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"section_id":          article.SectionID,
+		"title":               article.Title,
+		"body":                article.Body,
+		"locale":              article.Locale,
+		"permission_group_id": article.PermissionGroupID,
+		"user_segment_id":     0,
+		"author_id":           article.AuthorID,
+		"draft":               article.Draft,
+		"promoted":            article.Promoted,
+		"comments_disabled":   article.CommentsDisabled,
+		"label_names":         article.LabelNames,
+		"position":            article.Position,
+		"source_locale":       article.SourceLocale,
+		"html_url":            article.HTMLURL,
+		"url":                 article.URL,
+	}
+
+	if article.UserSegmentID != nil {
+		fields["user_segment_id"] = *article.UserSegmentID
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+func unmarshalHelpCenterArticle(d identifiableGetterSetter) (helpCenterArticle, error) {
+	article := helpCenterArticle{
+		LabelNames: []string{},
+	}
+
+	if v := d.Id(); v != "" {
+		id, err := atoi64(v)
+		if err != nil {
+			return article, fmt.Errorf("could not parse help center article id %s: %v", v, err)
+		}
+		article.ID = id
+	}
+
+	if v, ok := d.GetOk("section_id"); ok {
+		article.SectionID = int64(v.(int))
+	}
+
+	if v, ok := d.GetOk("title"); ok {
+		article.Title = v.(string)
+	}
+
+	if v, ok := d.GetOk("body_file_path"); ok {
+		body, err := readHelpCenterArticleBody(v.(string))
+		if err != nil {
+			return article, err
+		}
+		article.Body = body
+	} else if v, ok := d.GetOk("body"); ok {
+		article.Body = v.(string)
+	}
+
+	if v, ok := d.GetOk("locale"); ok {
+		article.Locale = v.(string)
+	}
+
+	if v, ok := d.GetOk("permission_group_id"); ok {
+		article.PermissionGroupID = int64(v.(int))
+	}
+
+	if v, ok := d.GetOk("user_segment_id"); ok {
+		userSegmentID := int64(v.(int))
+		article.UserSegmentID = &userSegmentID
+	}
+
+	if v, ok := d.GetOk("author_id"); ok {
+		article.AuthorID = int64(v.(int))
+	}
+
+	if v, ok := d.GetOk("draft"); ok {
+		article.Draft = v.(bool)
+	}
+
+	if v, ok := d.GetOk("promoted"); ok {
+		article.Promoted = v.(bool)
+	}
+
+	if v, ok := d.GetOk("comments_disabled"); ok {
+		article.CommentsDisabled = v.(bool)
+	}
+
+	if v, ok := d.GetOk("label_names"); ok {
+		for _, label := range v.(*schema.Set).List() {
+			article.LabelNames = append(article.LabelNames, label.(string))
+		}
+	}
+
+	if v, ok := d.GetOk("position"); ok {
+		article.Position = int64(v.(int))
+	}
+
+	return article, nil
+}
+
+func createHelpCenterArticle(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	article, err := unmarshalHelpCenterArticle(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result struct {
+		Article helpCenterArticle `json:"article"`
+	}
+	data := struct {
+		Article           helpCenterArticle `json:"article"`
+		NotifySubscribers bool              `json:"notify_subscribers"`
+	}{
+		Article: article,
+	}
+
+	err = postJSON(ctx, zd, helpCenterPath(article.Locale, "/sections/%d/articles.json", article.SectionID), data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", result.Article.ID))
+
+	err = marshalHelpCenterArticle(result.Article, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = setHelpCenterArticleBodyFileHash(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readHelpCenterArticle(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	locale, _ := d.Get("locale").(string)
+
+	var result struct {
+		Article helpCenterArticle `json:"article"`
+	}
+	err = getJSON(ctx, zd, helpCenterPath(locale, "/articles/%d.json", id), &result)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalHelpCenterArticle(result.Article, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateHelpCenterArticle(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	article, err := unmarshalHelpCenterArticle(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = putHelpCenterTranslation(ctx, zd, "articles", article.ID, article.Locale, helpCenterTranslation{
+		Title: article.Title,
+		Body:  article.Body,
+		Draft: article.Draft,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := map[string]interface{}{
+		"article": map[string]interface{}{
+			"section_id":          article.SectionID,
+			"permission_group_id": article.PermissionGroupID,
+			"user_segment_id":     article.UserSegmentID,
+			"author_id":           article.AuthorID,
+			"promoted":            article.Promoted,
+			"comments_disabled":   article.CommentsDisabled,
+			"label_names":         article.LabelNames,
+			"position":            article.Position,
+		},
+	}
+	err = putJSON(ctx, zd, fmt.Sprintf("/help_center/articles/%d.json", article.ID), data, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = setHelpCenterArticleBodyFileHash(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return readHelpCenterArticle(ctx, d, zd)
+}
+
+func deleteHelpCenterArticle(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// deleting an article archives it
+	err = zd.Delete(ctx, fmt.Sprintf("/help_center/articles/%d.json", id))
+	if err != nil && !isNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
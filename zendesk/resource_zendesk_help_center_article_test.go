@@ -0,0 +1,235 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestReadHelpCenterArticleBody(t *testing.T) {
+	body, err := readHelpCenterArticleBody("testdata/article.md")
+	if err != nil {
+		t.Fatalf("readHelpCenterArticleBody returned an error: %v", err)
+	}
+
+	for _, s := range []string{"<h1>Resetting a T-800</h1>", "<strong>ten seconds</strong>", "<ol>"} {
+		if !strings.Contains(body, s) {
+			t.Fatalf("rendered body did not contain %s. body was %s", s, body)
+		}
+	}
+
+	if _, err := readHelpCenterArticleBody("testdata/missing.html"); err == nil {
+		t.Fatal("readHelpCenterArticleBody did not return an error for a missing file")
+	}
+}
+
+func TestUnmarshalHelpCenterArticle(t *testing.T) {
+	i := &identifiableMapGetterSetter{
+		id: "4567",
+		mapGetterSetter: mapGetterSetter{
+			"section_id":          3571,
+			"title":               "Resetting a T-800",
+			"body":                "<p>Hold the reset switch.</p>",
+			"permission_group_id": 7,
+			"label_names":         schema.NewSet(schema.HashString, []interface{}{"t800"}),
+		},
+	}
+
+	article, err := unmarshalHelpCenterArticle(i)
+	if err != nil {
+		t.Fatalf("unmarshal returned an error: %v", err)
+	}
+
+	if article.ID != 4567 || article.SectionID != 3571 || article.PermissionGroupID != 7 {
+		t.Fatalf("article had id %d, section id %d and permission group id %d", article.ID, article.SectionID, article.PermissionGroupID)
+	}
+
+	if article.Body != "<p>Hold the reset switch.</p>" {
+		t.Fatalf("article had body %s", article.Body)
+	}
+
+	if article.UserSegmentID != nil {
+		t.Fatalf("article had user segment id %d. should have been visible to everybody", *article.UserSegmentID)
+	}
+
+	if len(article.LabelNames) != 1 || article.LabelNames[0] != "t800" {
+		t.Fatalf("article had label names %v", article.LabelNames)
+	}
+}
+
+func TestCreateHelpCenterArticleFromFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"section_id":          3571,
+			"title":               "Resetting a T-800",
+			"body_file_path":      "testdata/article.md",
+			"locale":              "en-us",
+			"permission_group_id": 7,
+		},
+	}
+	out := []byte(`{"article": {"id": 4567, "section_id": 3571, "title": "Resetting a T-800", "body": "<h1>Resetting a T-800</h1>", "locale": "en-us", "permission_group_id": 7, "user_segment_id": null, "label_names": []}}`)
+
+	m.EXPECT().Post(gomock.Any(), gomock.Eq("/help_center/en-us/sections/3571/articles.json"), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, path string, data interface{}) ([]byte, error) {
+			b, err := json.Marshal(data)
+			if err != nil {
+				t.Fatalf("could not marshal the article: %v", err)
+			}
+			var created struct {
+				Article           helpCenterArticle `json:"article"`
+				NotifySubscribers *bool             `json:"notify_subscribers"`
+			}
+			if err := json.Unmarshal(b, &created); err != nil {
+				t.Fatalf("could not unmarshal the article: %v", err)
+			}
+			if created.NotifySubscribers == nil || *created.NotifySubscribers || !strings.Contains(created.Article.Body, "<strong>") {
+				t.Fatalf("created article was %s", b)
+			}
+			return out, nil
+		})
+	if diags := createHelpCenterArticle(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createHelpCenterArticle returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "4567" {
+		t.Fatalf("createHelpCenterArticle did not set resource id. Id was %s", v)
+	}
+
+	hash, err := fileHash("testdata/article.md")
+	if err != nil {
+		t.Fatalf("fileHash returned an error: %v", err)
+	}
+
+	if v := i.Get("body_file_hash"); v != hash {
+		t.Fatalf("createHelpCenterArticle did not set body_file_hash. body_file_hash was %v", v)
+	}
+}
+
+func TestReadHelpCenterArticleNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "4567",
+		mapGetterSetter: mapGetterSetter{
+			"locale": "en-us",
+		},
+	}
+
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/help_center/en-us/articles/4567.json")).Return(nil, notFoundError())
+	if diags := readHelpCenterArticle(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readHelpCenterArticle returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "" {
+		t.Fatalf("readHelpCenterArticle did not remove the deleted article. Id was %s", v)
+	}
+}
+
+func TestUpdateHelpCenterArticle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "4567",
+		mapGetterSetter: mapGetterSetter{
+			"section_id":          3571,
+			"title":               "Resetting a T-800",
+			"body":                "<p>Hold the reset switch.</p>",
+			"locale":              "en-us",
+			"permission_group_id": 7,
+			"user_segment_id":     9,
+			"draft":               true,
+		},
+	}
+
+	userSegmentID := int64(9)
+	gomock.InOrder(
+		m.EXPECT().Put(gomock.Any(), gomock.Eq("/help_center/articles/4567/translations/en-us.json"), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, path string, data interface{}) ([]byte, error) {
+				tr := data.(struct {
+					Translation helpCenterTranslation `json:"translation"`
+				}).Translation
+				if tr.Title != "Resetting a T-800" || tr.Body != "<p>Hold the reset switch.</p>" || !tr.Draft {
+					t.Fatalf("updated translation was %v", tr)
+				}
+				return []byte(`{"translation": {}}`), nil
+			}),
+		m.EXPECT().Put(gomock.Any(), gomock.Eq("/help_center/articles/4567.json"), gomock.Eq(map[string]interface{}{
+			"article": map[string]interface{}{
+				"section_id":          int64(3571),
+				"permission_group_id": int64(7),
+				"user_segment_id":     &userSegmentID,
+				"author_id":           int64(0),
+				"promoted":            false,
+				"comments_disabled":   false,
+				"label_names":         []string{},
+				"position":            int64(0),
+			},
+		})).Return([]byte(`{"article": {}}`), nil),
+		m.EXPECT().Get(gomock.Any(), gomock.Eq("/help_center/en-us/articles/4567.json")).
+			Return([]byte(`{"article": {"id": 4567, "section_id": 3571, "title": "Resetting a T-800", "locale": "en-us", "permission_group_id": 7, "user_segment_id": 9, "draft": true}}`), nil),
+	)
+
+	if diags := updateHelpCenterArticle(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateHelpCenterArticle returned an error: %v", diags)
+	}
+
+	if v := i.Get("user_segment_id"); v != int64(9) {
+		t.Fatalf("updateHelpCenterArticle did not read user_segment_id. user_segment_id was %v", v)
+	}
+}
+
+func TestDeleteHelpCenterArticle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("4567")
+
+	m.EXPECT().Delete(gomock.Any(), gomock.Eq("/help_center/articles/4567.json")).Return(nil)
+	if diags := deleteHelpCenterArticle(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteHelpCenterArticle returned an error: %v", diags)
+	}
+}
+
+func TestAccHelpCenterArticleExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testHelpCenterDestroyed("zendesk_help_center_category", "/help_center/categories/%s.json"),
+		Steps: []resource.TestStep{
+			{
+				Config: concatExampleConfig(t,
+					readExampleConfig(t, "resources/zendesk_help_center_category/resource.tf"),
+					readExampleConfig(t, "resources/zendesk_help_center_section/resource.tf"),
+					readExampleConfig(t, "resources/zendesk_help_center_user_segment/resource.tf"),
+					readExampleConfig(t, "resources/zendesk_help_center_permission_group/resource.tf"),
+					readExampleConfig(t, "resources/zendesk_help_center_article/resource.tf"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zendesk_help_center_article.t800-reset", "title", "Resetting a T-800"),
+					resource.TestCheckResourceAttrSet("zendesk_help_center_article.t800-reset", "body_file_hash"),
+					resource.TestCheckResourceAttrSet("zendesk_help_center_article.t800-reset", "html_url"),
+					resource.TestCheckResourceAttrPair("zendesk_help_center_article.t800-reset", "permission_group_id", "zendesk_help_center_permission_group.cyberdyne-engineering", "id"),
+					resource.TestCheckResourceAttrPair("zendesk_help_center_article.t800-reset", "user_segment_id", "zendesk_help_center_user_segment.resistance", "id"),
+				),
+			},
+		},
+	})
+}
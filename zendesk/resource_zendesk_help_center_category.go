@@ -0,0 +1,231 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// helpCenterCategory is the Help Center category JSON payload format
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/categories/#json-format
+type helpCenterCategory struct {
+	ID           int64  `json:"id,omitempty"`
+	URL          string `json:"url,omitempty"`
+	HTMLURL      string `json:"html_url,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Description  string `json:"description"`
+	Locale       string `json:"locale,omitempty"`
+	SourceLocale string `json:"source_locale,omitempty"`
+	Position     int64  `json:"position,omitempty"`
+}
+
+// https://developer.zendesk.com/api-reference/help_center/help-center-api/categories/
+func resourceZendeskHelpCenterCategory() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Help Center category resource. Destroying a category also deletes its sections and articles.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return createHelpCenterCategory(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return readHelpCenterCategory(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return updateHelpCenterCategory(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return deleteHelpCenterCategory(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the category in its locale.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The description of the category in its locale.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
+			"locale": helpCenterLocaleSchema("The locale the category is created and managed in."),
+			"position": {
+				Description: "The position of the category relative to the other categories.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"source_locale": {
+				Description: "The source (default) locale of the category.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"html_url": {
+				Description: "The url of the category in Help Center.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"url": {
+				Description: "The API url of the category.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func marshalHelpCenterCategory(category helpCenterCategory, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"name":          category.Name,
+		"description":   category.Description,
+		"locale":        category.Locale,
+		"position":      category.Position,
+		"source_locale": category.SourceLocale,
+		"html_url":      category.HTMLURL,
+		"url":           category.URL,
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+func unmarshalHelpCenterCategory(d identifiableGetterSetter) (helpCenterCategory, error) {
+	category := helpCenterCategory{}
+
+	if v := d.Id(); v != "" {
+		id, err := atoi64(v)
+		if err != nil {
+			return category, fmt.Errorf("could not parse help center category id %s: %v", v, err)
+		}
+		category.ID = id
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		category.Name = v.(string)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		category.Description = v.(string)
+	}
+
+	if v, ok := d.GetOk("locale"); ok {
+		category.Locale = v.(string)
+	}
+
+	if v, ok := d.GetOk("position"); ok {
+		category.Position = int64(v.(int))
+	}
+
+	return category, nil
+}
+
+func createHelpCenterCategory(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	category, err := unmarshalHelpCenterCategory(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var data, result struct {
+		Category helpCenterCategory `json:"category"`
+	}
+	data.Category = category
+
+	err = postJSON(ctx, zd, helpCenterPath(category.Locale, "/categories.json"), data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", result.Category.ID))
+
+	err = marshalHelpCenterCategory(result.Category, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readHelpCenterCategory(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	locale, _ := d.Get("locale").(string)
+
+	var result struct {
+		Category helpCenterCategory `json:"category"`
+	}
+	err = getJSON(ctx, zd, helpCenterPath(locale, "/categories/%d.json", id), &result)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalHelpCenterCategory(result.Category, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateHelpCenterCategory(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	category, err := unmarshalHelpCenterCategory(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = putHelpCenterTranslation(ctx, zd, "categories", category.ID, category.Locale, helpCenterTranslation{
+		Title: category.Name,
+		Body:  category.Description,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := map[string]interface{}{
+		"category": map[string]interface{}{
+			"position": category.Position,
+		},
+	}
+	err = putJSON(ctx, zd, fmt.Sprintf("/help_center/categories/%d.json", category.ID), data, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return readHelpCenterCategory(ctx, d, zd)
+}
+
+func deleteHelpCenterCategory(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = zd.Delete(ctx, fmt.Sprintf("/help_center/categories/%d.json", id))
+	if err != nil && !isNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,170 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nukosuke/go-zendesk/zendesk"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestHelpCenterPath(t *testing.T) {
+	if v := helpCenterPath("", "/categories/%d.json", 1); v != "/help_center/categories/1.json" {
+		t.Fatalf("path without locale was %s", v)
+	}
+
+	if v := helpCenterPath("en-us", "/categories/%d.json", 1); v != "/help_center/en-us/categories/1.json" {
+		t.Fatalf("path with locale was %s", v)
+	}
+}
+
+func TestCreateHelpCenterCategory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"name":   "Cyberdyne Systems",
+			"locale": "en-us",
+		},
+	}
+	out := []byte(`{"category": {"id": 1635, "name": "Cyberdyne Systems", "locale": "en-us", "source_locale": "en-us", "position": 0}}`)
+
+	m.EXPECT().Post(gomock.Any(), gomock.Eq("/help_center/en-us/categories.json"), gomock.Any()).Return(out, nil)
+	if diags := createHelpCenterCategory(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createHelpCenterCategory returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "1635" {
+		t.Fatalf("createHelpCenterCategory did not set resource id. Id was %s", v)
+	}
+
+	if v := i.Get("source_locale"); v != "en-us" {
+		t.Fatalf("createHelpCenterCategory did not set source_locale. source_locale was %v", v)
+	}
+}
+
+func TestReadHelpCenterCategoryWithoutLocale(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("1635")
+
+	// an imported category is read in its source locale
+	out := []byte(`{"category": {"id": 1635, "name": "Cyberdyne Systems", "locale": "en-us", "source_locale": "en-us"}}`)
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/help_center/categories/1635.json")).Return(out, nil)
+	if diags := readHelpCenterCategory(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readHelpCenterCategory returned an error: %v", diags)
+	}
+
+	if v := i.Get("locale"); v != "en-us" {
+		t.Fatalf("readHelpCenterCategory did not set locale. locale was %v", v)
+	}
+}
+
+func TestUpdateHelpCenterCategory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "1635",
+		mapGetterSetter: mapGetterSetter{
+			"name":        "Skynet",
+			"description": "Formerly Cyberdyne Systems.",
+			"locale":      "en-us",
+			"position":    2,
+		},
+	}
+
+	translation := map[string]interface{}{}
+	gomock.InOrder(
+		m.EXPECT().Put(gomock.Any(), gomock.Eq("/help_center/categories/1635/translations/en-us.json"), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, path string, data interface{}) ([]byte, error) {
+				tr := data.(struct {
+					Translation helpCenterTranslation `json:"translation"`
+				}).Translation
+				translation["title"] = tr.Title
+				translation["body"] = tr.Body
+				return []byte(`{"translation": {}}`), nil
+			}),
+		m.EXPECT().Put(gomock.Any(), gomock.Eq("/help_center/categories/1635.json"), gomock.Eq(map[string]interface{}{
+			"category": map[string]interface{}{"position": int64(2)},
+		})).Return([]byte(`{"category": {}}`), nil),
+		m.EXPECT().Get(gomock.Any(), gomock.Eq("/help_center/en-us/categories/1635.json")).
+			Return([]byte(`{"category": {"id": 1635, "name": "Skynet", "description": "Formerly Cyberdyne Systems.", "locale": "en-us", "position": 2}}`), nil),
+	)
+
+	if diags := updateHelpCenterCategory(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateHelpCenterCategory returned an error: %v", diags)
+	}
+
+	if translation["title"] != "Skynet" || translation["body"] != "Formerly Cyberdyne Systems." {
+		t.Fatalf("updateHelpCenterCategory sent the translation %v", translation)
+	}
+}
+
+func TestDeleteHelpCenterCategory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("1635")
+
+	m.EXPECT().Delete(gomock.Any(), gomock.Eq("/help_center/categories/1635.json")).Return(nil)
+	if diags := deleteHelpCenterCategory(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteHelpCenterCategory returned an error: %v", diags)
+	}
+}
+
+// testHelpCenterDestroyed checks the Help Center resources of a type were deleted
+func testHelpCenterDestroyed(resourceType, path string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(zendesk.BaseAPI)
+
+		for k, r := range s.RootModule().Resources {
+			if r.Type != resourceType {
+				continue
+			}
+
+			_, err := client.Get(context.Background(), fmt.Sprintf(path, r.Primary.ID))
+			if err == nil {
+				return fmt.Errorf("did not get error from zendesk when trying to fetch the destroyed %s named %s", resourceType, k)
+			}
+
+			if !isNotFound(err) {
+				return fmt.Errorf("did not get a not found error after destroy. error was %v", err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func TestAccHelpCenterCategoryExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testHelpCenterDestroyed("zendesk_help_center_category", "/help_center/categories/%s.json"),
+		Steps: []resource.TestStep{
+			{
+				Config: readExampleConfig(t, "resources/zendesk_help_center_category/resource.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zendesk_help_center_category.cyberdyne", "name", "Cyberdyne Systems"),
+					resource.TestCheckResourceAttr("zendesk_help_center_category.cyberdyne", "locale", "en-us"),
+					resource.TestCheckResourceAttrSet("zendesk_help_center_category.cyberdyne", "html_url"),
+				),
+			},
+		},
+	})
+}
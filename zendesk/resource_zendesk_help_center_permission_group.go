@@ -0,0 +1,218 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// helpCenterPermissionGroup is the Help Center permission group JSON payload format
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/permission_groups/#json-format
+type helpCenterPermissionGroup struct {
+	ID      int64   `json:"id,omitempty"`
+	Name    string  `json:"name"`
+	Edit    []int64 `json:"edit"`
+	Publish []int64 `json:"publish"`
+	BuiltIn bool    `json:"built_in,omitempty"`
+}
+
+// https://developer.zendesk.com/api-reference/help_center/help-center-api/permission_groups/
+func resourceZendeskHelpCenterPermissionGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Help Center permission group resource. Permission groups define which agents can edit and publish articles.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return createHelpCenterPermissionGroup(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return readHelpCenterPermissionGroup(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return updateHelpCenterPermissionGroup(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return deleteHelpCenterPermissionGroup(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the permission group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"edit": {
+				Description: "The ids of the staff user segments whose agents can edit articles.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Optional: true,
+			},
+			"publish": {
+				Description: "The ids of the staff user segments whose agents can publish articles.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Optional: true,
+			},
+			"built_in": {
+				Description: "Whether the permission group is built in. Built in permission groups can't be modified.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func marshalHelpCenterPermissionGroup(group helpCenterPermissionGroup, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"name":     group.Name,
+		"edit":     group.Edit,
+		"publish":  group.Publish,
+		"built_in": group.BuiltIn,
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+func unmarshalHelpCenterPermissionGroup(d identifiableGetterSetter) (helpCenterPermissionGroup, error) {
+	group := helpCenterPermissionGroup{
+		Edit:    []int64{},
+		Publish: []int64{},
+	}
+
+	if v := d.Id(); v != "" {
+		id, err := atoi64(v)
+		if err != nil {
+			return group, fmt.Errorf("could not parse help center permission group id %s: %v", v, err)
+		}
+		group.ID = id
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		group.Name = v.(string)
+	}
+
+	if v, ok := d.GetOk("edit"); ok {
+		for _, id := range v.(*schema.Set).List() {
+			group.Edit = append(group.Edit, int64(id.(int)))
+		}
+	}
+
+	if v, ok := d.GetOk("publish"); ok {
+		for _, id := range v.(*schema.Set).List() {
+			group.Publish = append(group.Publish, int64(id.(int)))
+		}
+	}
+
+	return group, nil
+}
+
+func createHelpCenterPermissionGroup(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	group, err := unmarshalHelpCenterPermissionGroup(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var data, result struct {
+		PermissionGroup helpCenterPermissionGroup `json:"permission_group"`
+	}
+	data.PermissionGroup = group
+
+	err = postJSON(ctx, zd, "/guide/permission_groups.json", data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", result.PermissionGroup.ID))
+
+	err = marshalHelpCenterPermissionGroup(result.PermissionGroup, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readHelpCenterPermissionGroup(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result struct {
+		PermissionGroup helpCenterPermissionGroup `json:"permission_group"`
+	}
+	err = getJSON(ctx, zd, fmt.Sprintf("/guide/permission_groups/%d.json", id), &result)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalHelpCenterPermissionGroup(result.PermissionGroup, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateHelpCenterPermissionGroup(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	group, err := unmarshalHelpCenterPermissionGroup(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var data, result struct {
+		PermissionGroup helpCenterPermissionGroup `json:"permission_group"`
+	}
+	data.PermissionGroup = group
+
+	err = putJSON(ctx, zd, fmt.Sprintf("/guide/permission_groups/%d.json", group.ID), data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalHelpCenterPermissionGroup(result.PermissionGroup, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func deleteHelpCenterPermissionGroup(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = zd.Delete(ctx, fmt.Sprintf("/guide/permission_groups/%d.json", id))
+	if err != nil && !isNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,128 @@
+package zendesk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestCreateHelpCenterPermissionGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"name":    "Cyberdyne engineering",
+			"edit":    schema.NewSet(schema.HashInt, []interface{}{8902}),
+			"publish": schema.NewSet(schema.HashInt, []interface{}{8902}),
+		},
+	}
+	out := []byte(`{"permission_group": {"id": 4321, "name": "Cyberdyne engineering", "edit": [8902], "publish": [8902], "built_in": false}}`)
+
+	m.EXPECT().Post(gomock.Any(), gomock.Eq("/guide/permission_groups.json"), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, path string, data interface{}) ([]byte, error) {
+			group := data.(struct {
+				PermissionGroup helpCenterPermissionGroup `json:"permission_group"`
+			}).PermissionGroup
+			if len(group.Edit) != 1 || group.Edit[0] != 8902 || len(group.Publish) != 1 {
+				t.Fatalf("created permission group was %v", group)
+			}
+			return out, nil
+		})
+	if diags := createHelpCenterPermissionGroup(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createHelpCenterPermissionGroup returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "4321" {
+		t.Fatalf("createHelpCenterPermissionGroup did not set resource id. Id was %s", v)
+	}
+}
+
+func TestReadHelpCenterPermissionGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("4321")
+
+	out := []byte(`{"permission_group": {"id": 4321, "name": "Admins", "edit": [], "publish": [], "built_in": true}}`)
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/guide/permission_groups/4321.json")).Return(out, nil)
+	if diags := readHelpCenterPermissionGroup(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readHelpCenterPermissionGroup returned an error: %v", diags)
+	}
+
+	if v := i.Get("built_in"); v != true {
+		t.Fatalf("readHelpCenterPermissionGroup did not set built_in. built_in was %v", v)
+	}
+}
+
+func TestUpdateHelpCenterPermissionGroupClearsPublish(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "4321",
+		mapGetterSetter: mapGetterSetter{
+			"name": "Cyberdyne engineering",
+			"edit": schema.NewSet(schema.HashInt, []interface{}{8902}),
+		},
+	}
+	out := []byte(`{"permission_group": {"id": 4321, "name": "Cyberdyne engineering", "edit": [8902], "publish": []}}`)
+
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/guide/permission_groups/4321.json"), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, path string, data interface{}) ([]byte, error) {
+			group := data.(struct {
+				PermissionGroup helpCenterPermissionGroup `json:"permission_group"`
+			}).PermissionGroup
+			if group.Publish == nil || len(group.Publish) != 0 {
+				t.Fatalf("updated permission group had publish %v. should have been empty", group.Publish)
+			}
+			return out, nil
+		})
+	if diags := updateHelpCenterPermissionGroup(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateHelpCenterPermissionGroup returned an error: %v", diags)
+	}
+}
+
+func TestDeleteHelpCenterPermissionGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("4321")
+
+	m.EXPECT().Delete(gomock.Any(), gomock.Eq("/guide/permission_groups/4321.json")).Return(notFoundError())
+	if diags := deleteHelpCenterPermissionGroup(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteHelpCenterPermissionGroup returned an error: %v", diags)
+	}
+}
+
+func TestAccHelpCenterPermissionGroupExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testHelpCenterDestroyed("zendesk_help_center_permission_group", "/guide/permission_groups/%s.json"),
+		Steps: []resource.TestStep{
+			{
+				Config: concatExampleConfig(t,
+					readExampleConfig(t, "resources/zendesk_help_center_user_segment/resource.tf"),
+					readExampleConfig(t, "resources/zendesk_help_center_permission_group/resource.tf"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zendesk_help_center_permission_group.cyberdyne-engineering", "name", "Cyberdyne engineering"),
+					resource.TestCheckResourceAttr("zendesk_help_center_permission_group.cyberdyne-engineering", "edit.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,260 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// helpCenterSection is the Help Center section JSON payload format
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/sections/#json-format
+type helpCenterSection struct {
+	ID              int64  `json:"id,omitempty"`
+	URL             string `json:"url,omitempty"`
+	HTMLURL         string `json:"html_url,omitempty"`
+	CategoryID      int64  `json:"category_id,omitempty"`
+	ParentSectionID *int64 `json:"parent_section_id"`
+	Name            string `json:"name,omitempty"`
+	Description     string `json:"description"`
+	Locale          string `json:"locale,omitempty"`
+	SourceLocale    string `json:"source_locale,omitempty"`
+	Position        int64  `json:"position,omitempty"`
+}
+
+// https://developer.zendesk.com/api-reference/help_center/help-center-api/sections/
+func resourceZendeskHelpCenterSection() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Help Center section resource. Destroying a section also deletes its articles and subsections.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return createHelpCenterSection(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return readHelpCenterSection(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return updateHelpCenterSection(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return deleteHelpCenterSection(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"category_id": {
+				Description: "The id of the category the section belongs to.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"parent_section_id": {
+				Description: "The id of the parent section, for subsections. The parent section must belong to the same category.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"name": {
+				Description: "The name of the section in its locale.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The description of the section in its locale.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
+			"locale": helpCenterLocaleSchema("The locale the section is created and managed in."),
+			"position": {
+				Description: "The position of the section relative to the other sections of its category or parent section.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"source_locale": {
+				Description: "The source (default) locale of the section.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"html_url": {
+				Description: "The url of the section in Help Center.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"url": {
+				Description: "The API url of the section.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func marshalHelpCenterSection(section helpCenterSection, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"category_id":       section.CategoryID,
+		"parent_section_id": 0,
+		"name":              section.Name,
+		"description":       section.Description,
+		"locale":            section.Locale,
+		"position":          section.Position,
+		"source_locale":     section.SourceLocale,
+		"html_url":          section.HTMLURL,
+		"url":               section.URL,
+	}
+
+	if section.ParentSectionID != nil {
+		fields["parent_section_id"] = *section.ParentSectionID
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+func unmarshalHelpCenterSection(d identifiableGetterSetter) (helpCenterSection, error) {
+	section := helpCenterSection{}
+
+	if v := d.Id(); v != "" {
+		id, err := atoi64(v)
+		if err != nil {
+			return section, fmt.Errorf("could not parse help center section id %s: %v", v, err)
+		}
+		section.ID = id
+	}
+
+	if v, ok := d.GetOk("category_id"); ok {
+		section.CategoryID = int64(v.(int))
+	}
+
+	if v, ok := d.GetOk("parent_section_id"); ok {
+		parentSectionID := int64(v.(int))
+		section.ParentSectionID = &parentSectionID
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		section.Name = v.(string)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		section.Description = v.(string)
+	}
+
+	if v, ok := d.GetOk("locale"); ok {
+		section.Locale = v.(string)
+	}
+
+	if v, ok := d.GetOk("position"); ok {
+		section.Position = int64(v.(int))
+	}
+
+	return section, nil
+}
+
+func createHelpCenterSection(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	section, err := unmarshalHelpCenterSection(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var data, result struct {
+		Section helpCenterSection `json:"section"`
+	}
+	data.Section = section
+
+	err = postJSON(ctx, zd, helpCenterPath(section.Locale, "/categories/%d/sections.json", section.CategoryID), data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", result.Section.ID))
+
+	err = marshalHelpCenterSection(result.Section, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readHelpCenterSection(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	locale, _ := d.Get("locale").(string)
+
+	var result struct {
+		Section helpCenterSection `json:"section"`
+	}
+	err = getJSON(ctx, zd, helpCenterPath(locale, "/sections/%d.json", id), &result)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalHelpCenterSection(result.Section, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateHelpCenterSection(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	section, err := unmarshalHelpCenterSection(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = putHelpCenterTranslation(ctx, zd, "sections", section.ID, section.Locale, helpCenterTranslation{
+		Title: section.Name,
+		Body:  section.Description,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := map[string]interface{}{
+		"section": map[string]interface{}{
+			"category_id":       section.CategoryID,
+			"parent_section_id": section.ParentSectionID,
+			"position":          section.Position,
+		},
+	}
+	err = putJSON(ctx, zd, fmt.Sprintf("/help_center/sections/%d.json", section.ID), data, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return readHelpCenterSection(ctx, d, zd)
+}
+
+func deleteHelpCenterSection(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = zd.Delete(ctx, fmt.Sprintf("/help_center/sections/%d.json", id))
+	if err != nil && !isNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,155 @@
+package zendesk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestUnmarshalHelpCenterSection(t *testing.T) {
+	i := &identifiableMapGetterSetter{
+		id: "3571",
+		mapGetterSetter: mapGetterSetter{
+			"category_id":       1635,
+			"parent_section_id": 3570,
+			"name":              "Maintenance",
+			"locale":            "en-us",
+		},
+	}
+
+	section, err := unmarshalHelpCenterSection(i)
+	if err != nil {
+		t.Fatalf("unmarshal returned an error: %v", err)
+	}
+
+	if section.ID != 3571 || section.CategoryID != 1635 {
+		t.Fatalf("section had id %d and category id %d", section.ID, section.CategoryID)
+	}
+
+	if section.ParentSectionID == nil || *section.ParentSectionID != 3570 {
+		t.Fatalf("section had parent section id %v. should have been 3570", section.ParentSectionID)
+	}
+}
+
+func TestCreateHelpCenterSection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"category_id": 1635,
+			"name":        "T-800",
+		},
+	}
+	out := []byte(`{"section": {"id": 3570, "category_id": 1635, "parent_section_id": null, "name": "T-800", "locale": "en-us"}}`)
+
+	m.EXPECT().Post(gomock.Any(), gomock.Eq("/help_center/categories/1635/sections.json"), gomock.Any()).Return(out, nil)
+	if diags := createHelpCenterSection(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createHelpCenterSection returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "3570" {
+		t.Fatalf("createHelpCenterSection did not set resource id. Id was %s", v)
+	}
+
+	if v := i.Get("parent_section_id"); v != 0 {
+		t.Fatalf("createHelpCenterSection set parent_section_id %v for a top level section", v)
+	}
+}
+
+func TestReadHelpCenterSection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "3571",
+		mapGetterSetter: mapGetterSetter{
+			"locale": "de",
+		},
+	}
+
+	out := []byte(`{"section": {"id": 3571, "category_id": 1635, "parent_section_id": 3570, "name": "Wartung", "locale": "de", "source_locale": "en-us"}}`)
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/help_center/de/sections/3571.json")).Return(out, nil)
+	if diags := readHelpCenterSection(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readHelpCenterSection returned an error: %v", diags)
+	}
+
+	if v := i.Get("parent_section_id"); v != int64(3570) {
+		t.Fatalf("readHelpCenterSection did not set parent_section_id. parent_section_id was %v", v)
+	}
+
+	if v := i.Get("name"); v != "Wartung" {
+		t.Fatalf("readHelpCenterSection did not read the name in the locale. name was %v", v)
+	}
+}
+
+func TestUpdateHelpCenterSectionMovesToTopLevel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "3571",
+		mapGetterSetter: mapGetterSetter{
+			"category_id": 1635,
+			"name":        "Maintenance",
+			"locale":      "en-us",
+		},
+	}
+
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/help_center/sections/3571/translations/en-us.json"), gomock.Any()).Return([]byte(`{}`), nil)
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/help_center/sections/3571.json"), gomock.Eq(map[string]interface{}{
+		"section": map[string]interface{}{
+			"category_id":       int64(1635),
+			"parent_section_id": (*int64)(nil),
+			"position":          int64(0),
+		},
+	})).Return([]byte(`{}`), nil)
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/help_center/en-us/sections/3571.json")).
+		Return([]byte(`{"section": {"id": 3571, "category_id": 1635, "name": "Maintenance", "locale": "en-us"}}`), nil)
+
+	if diags := updateHelpCenterSection(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateHelpCenterSection returned an error: %v", diags)
+	}
+}
+
+func TestDeleteHelpCenterSection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("3571")
+
+	m.EXPECT().Delete(gomock.Any(), gomock.Eq("/help_center/sections/3571.json")).Return(nil)
+	if diags := deleteHelpCenterSection(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteHelpCenterSection returned an error: %v", diags)
+	}
+}
+
+func TestAccHelpCenterSectionExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testHelpCenterDestroyed("zendesk_help_center_section", "/help_center/sections/%s.json"),
+		Steps: []resource.TestStep{
+			{
+				Config: concatExampleConfig(t,
+					readExampleConfig(t, "resources/zendesk_help_center_category/resource.tf"),
+					readExampleConfig(t, "resources/zendesk_help_center_section/resource.tf"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zendesk_help_center_section.t800", "name", "T-800"),
+					resource.TestCheckResourceAttrPair("zendesk_help_center_section.t800-maintenance", "parent_section_id", "zendesk_help_center_section.t800", "id"),
+				),
+			},
+		},
+	})
+}
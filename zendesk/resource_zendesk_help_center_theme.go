@@ -0,0 +1,487 @@
+package zendesk
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// helpCenterTheme is the Help Center theme JSON payload format
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/theming/#json-format
+type helpCenterTheme struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Author  string `json:"author"`
+	Version string `json:"version"`
+	Live    bool   `json:"live"`
+	BrandID string `json:"brand_id"`
+}
+
+// helpCenterThemeJob is the theming job JSON payload format
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/theming/#theme-job
+type helpCenterThemeJob struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	Errors []struct {
+		Title string `json:"title"`
+		Code  string `json:"code"`
+	} `json:"errors"`
+	Data struct {
+		ThemeID string `json:"theme_id"`
+		Upload  struct {
+			URL        string            `json:"url"`
+			Parameters map[string]string `json:"parameters"`
+		} `json:"upload"`
+	} `json:"data"`
+}
+
+// Interval between theming job checks while waiting for an import
+var helpCenterThemeJobPollInterval = 5 * time.Second
+
+// https://developer.zendesk.com/api-reference/help_center/help-center-api/theming/
+func resourceZendeskHelpCenterTheme() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Help Center theme resource, imported from a local theme directory. " +
+			"Changes to the files of the directory replace the theme. " +
+			"A live theme can't be deleted, so published themes should be replaced with `create_before_destroy`.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return createHelpCenterTheme(ctx, d, zd, d.Timeout(schema.TimeoutCreate))
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return readHelpCenterTheme(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return updateHelpCenterTheme(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return deleteHelpCenterTheme(ctx, d, zd)
+		},
+		CustomizeDiff: customizeHelpCenterThemeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Description: "Path to the theme directory, which contains the `manifest.json` of the theme. Files and directories starting with a dot are ignored.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"content_hash": {
+				Description: "SHA256 hash of the files of the theme directory, computed by the provider. The theme is replaced when it changes.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"brand_id": {
+				Description: "The id of the brand the theme belongs to.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"publish": {
+				Description: "Whether to publish the theme, making it live for the brand. Setting it back to false doesn't unpublish the theme; publish another one instead.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"live": {
+				Description: "Whether the theme is the live theme of its brand.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"name": {
+				Description: "The name of the theme, from its manifest.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"author": {
+				Description: "The author of the theme, from its manifest.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"version": {
+				Description: "The version of the theme, from its manifest.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// customizeHelpCenterThemeDiff plans the hash of the theme directory, so that changes to its files replace the theme
+func customizeHelpCenterThemeDiff(ctx context.Context, d *schema.ResourceDiff, i interface{}) error {
+	if config := d.GetRawConfig(); !config.IsNull() && !config.GetAttr("content_hash").IsNull() {
+		return nil
+	}
+
+	if !d.NewValueKnown("path") {
+		return d.SetNewComputed("content_hash")
+	}
+
+	hash, err := themeDirectoryHash(d.Get("path").(string))
+	if errors.Is(err, fs.ErrNotExist) {
+		return d.SetNewComputed("content_hash")
+	}
+	if err != nil {
+		return err
+	}
+
+	old := d.Get("content_hash").(string)
+	if old == hash {
+		return nil
+	}
+
+	if err := d.SetNew("content_hash", hash); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return nil
+	}
+	return d.ForceNew("content_hash")
+}
+
+// themeDirectoryFiles returns the slash separated paths of the files of a theme directory, sorted
+func themeDirectoryFiles(dir string) ([]string, error) {
+	files := make([]string, 0)
+	err := filepath.WalkDir(dir, func(path string, e fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if path != dir && strings.HasPrefix(e.Name(), ".") {
+			if e.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if !e.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
+
+// themeDirectoryHash hashes the paths and contents of the files of a theme directory
+func themeDirectoryHash(dir string) (string, error) {
+	files, err := themeDirectoryFiles(dir)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	for _, name := range files {
+		b, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if err != nil {
+			return "", err
+		}
+
+		// the length prefix keeps moved bytes between a path and the contents from hashing alike
+		fmt.Fprintf(h, "%s\x00%d\x00", name, len(b))
+		h.Write(b)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// zipThemeDirectory packages a theme directory as the zip archive expected by theme imports
+func zipThemeDirectory(dir string) ([]byte, error) {
+	files, err := themeDirectoryFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, name := range files {
+		b, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if err != nil {
+			return nil, err
+		}
+
+		f, err := w.Create(name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := f.Write(b); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// postHelpCenterThemeJob creates a theming job. These endpoints respond with "202 Accepted",
+// which the client returns as an error.
+func postHelpCenterThemeJob(ctx context.Context, zd client.BaseAPI, path string, data interface{}) (helpCenterThemeJob, error) {
+	var result struct {
+		Job helpCenterThemeJob `json:"job"`
+	}
+
+	body, err := zd.Post(ctx, path, data)
+	var zdErr client.Error
+	if errors.As(err, &zdErr) && zdErr.Status() == http.StatusAccepted {
+		body, err = io.ReadAll(zdErr.Body())
+	}
+	if err != nil {
+		return result.Job, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	return result.Job, err
+}
+
+// uploadHelpCenterTheme uploads the theme archive to the upload url of an import job
+func uploadHelpCenterTheme(ctx context.Context, job helpCenterThemeJob, archive []byte) error {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+
+	// the parameters sign the upload and must precede the file
+	keys := make([]string, 0, len(job.Data.Upload.Parameters))
+	for k := range job.Data.Upload.Parameters {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := w.WriteField(k, job.Data.Upload.Parameters[k]); err != nil {
+			return err
+		}
+	}
+
+	f, err := w.CreateFormFile("file", "theme.zip")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(archive); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, job.Data.Upload.URL, &buf)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		b, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("could not upload the theme: %s: %s", resp.Status, b)
+	}
+
+	return nil
+}
+
+// waitForHelpCenterThemeJob polls a theming job until it completes, fails or the timeout expires
+func waitForHelpCenterThemeJob(ctx context.Context, zd client.BaseAPI, id string, timeout time.Duration) (helpCenterThemeJob, error) {
+	conf := &retry.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"completed"},
+		Refresh: func() (interface{}, string, error) {
+			var result struct {
+				Job helpCenterThemeJob `json:"job"`
+			}
+			err := getJSON(ctx, zd, fmt.Sprintf("/guide/theming/jobs/%s", id), &result)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if result.Job.Status == "failed" {
+				messages := make([]string, 0, len(result.Job.Errors))
+				for _, e := range result.Job.Errors {
+					messages = append(messages, fmt.Sprintf("%s (%s)", e.Title, e.Code))
+				}
+				return nil, "", fmt.Errorf("theme import job %s failed: %s", id, strings.Join(messages, ", "))
+			}
+
+			return result.Job, result.Job.Status, nil
+		},
+		Timeout:      timeout,
+		PollInterval: helpCenterThemeJobPollInterval,
+	}
+
+	out, err := conf.WaitForStateContext(ctx)
+	if err != nil {
+		return helpCenterThemeJob{}, err
+	}
+
+	return out.(helpCenterThemeJob), nil
+}
+
+func publishHelpCenterTheme(ctx context.Context, zd client.BaseAPI, id string) error {
+	return postJSON(ctx, zd, fmt.Sprintf("/guide/theming/themes/%s/publish", id), map[string]interface{}{}, nil)
+}
+
+func marshalHelpCenterTheme(theme helpCenterTheme, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"live":    theme.Live,
+		"name":    theme.Name,
+		"author":  theme.Author,
+		"version": theme.Version,
+	}
+
+	// a theme which is no longer live is published again
+	if publish, _ := d.Get("publish").(bool); publish && !theme.Live {
+		fields["publish"] = false
+	}
+
+	if theme.BrandID != "" {
+		brandID, err := atoi64(theme.BrandID)
+		if err != nil {
+			return fmt.Errorf("could not parse brand id %s: %v", theme.BrandID, err)
+		}
+		fields["brand_id"] = brandID
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+func createHelpCenterTheme(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI, timeout time.Duration) diag.Diagnostics {
+	path := d.Get("path").(string)
+
+	archive, err := zipThemeDirectory(path)
+	if err != nil {
+		return diag.Errorf("could not package the theme directory %s: %v", path, err)
+	}
+
+	hash, err := themeDirectoryHash(path)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := map[string]interface{}{
+		"job": map[string]interface{}{
+			"attributes": map[string]interface{}{
+				"brand_id": fmt.Sprintf("%d", d.Get("brand_id").(int)),
+				"format":   "zip",
+			},
+		},
+	}
+	job, err := postHelpCenterThemeJob(ctx, zd, "/guide/theming/jobs/themes/imports", data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = uploadHelpCenterTheme(ctx, job, archive)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	job, err = waitForHelpCenterThemeJob(ctx, zd, job.ID, timeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(job.Data.ThemeID)
+
+	err = d.Set("content_hash", hash)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("publish").(bool) {
+		err = publishHelpCenterTheme(ctx, zd, d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return readHelpCenterTheme(ctx, d, zd)
+}
+
+func readHelpCenterTheme(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var result struct {
+		Theme helpCenterTheme `json:"theme"`
+	}
+	err := getJSON(ctx, zd, fmt.Sprintf("/guide/theming/themes/%s", d.Id()), &result)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalHelpCenterTheme(result.Theme, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateHelpCenterTheme(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	// the other attributes replace the theme
+	if d.Get("publish").(bool) {
+		err := publishHelpCenterTheme(ctx, zd, d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return readHelpCenterTheme(ctx, d, zd)
+}
+
+func deleteHelpCenterTheme(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := zd.Delete(ctx, fmt.Sprintf("/guide/theming/themes/%s", d.Id()))
+	if err != nil && !isNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
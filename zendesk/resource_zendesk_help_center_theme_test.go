@@ -0,0 +1,260 @@
+package zendesk
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// fakeThemingServer stands in for the theming jobs API and the upload storage
+type fakeThemingServer struct {
+	*httptest.Server
+	t *testing.T
+
+	mu        sync.Mutex
+	jobChecks int
+	jobStatus string
+	published bool
+	deleted   bool
+	uploaded  []string
+}
+
+func newFakeThemingServer(t *testing.T, jobStatus string) *fakeThemingServer {
+	s := &fakeThemingServer{t: t, jobStatus: jobStatus}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *fakeThemingServer) client() zendesk.BaseAPI {
+	zd, err := zendesk.NewClient(nil)
+	if err != nil {
+		s.t.Fatalf("could not create client: %v", err)
+	}
+	if err := zd.SetEndpointURL(s.URL); err != nil {
+		s.t.Fatalf("could not set endpoint: %v", err)
+	}
+	return zd
+}
+
+func (s *fakeThemingServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.Method + " " + r.URL.Path {
+	case "POST /guide/theming/jobs/themes/imports":
+		var data struct {
+			Job struct {
+				Attributes struct {
+					BrandID string `json:"brand_id"`
+					Format  string `json:"format"`
+				} `json:"attributes"`
+			} `json:"job"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil || data.Job.Attributes.BrandID != "360001" || data.Job.Attributes.Format != "zip" {
+			s.t.Errorf("unexpected import job %+v: %v", data, err)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = io.WriteString(w, `{"job": {"id": "job-1", "status": "pending", "data": {"theme_id": "theme-1", "upload": {"url": "`+s.URL+`/upload", "parameters": {"key": "themes/theme-1.zip", "policy": "signed"}}}}}`)
+	case "POST /upload":
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			s.t.Errorf("could not parse upload: %v", err)
+			return
+		}
+		if r.FormValue("key") != "themes/theme-1.zip" || r.FormValue("policy") != "signed" {
+			s.t.Errorf("upload did not carry the parameters: %v", r.MultipartForm.Value)
+		}
+		f, _, err := r.FormFile("file")
+		if err != nil {
+			s.t.Errorf("upload had no file: %v", err)
+			return
+		}
+		b, _ := io.ReadAll(f)
+		zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+		if err != nil {
+			s.t.Errorf("upload was not a zip archive: %v", err)
+			return
+		}
+		for _, e := range zr.File {
+			s.uploaded = append(s.uploaded, e.Name)
+		}
+		sort.Strings(s.uploaded)
+		w.WriteHeader(http.StatusNoContent)
+	case "GET /guide/theming/jobs/job-1":
+		s.jobChecks++
+		status := "pending"
+		if s.jobChecks > 1 {
+			status = s.jobStatus
+		}
+		_, _ = io.WriteString(w, `{"job": {"id": "job-1", "status": "`+status+`", "errors": [{"title": "Missing template", "code": "TemplateNotFound"}], "data": {"theme_id": "theme-1"}}}`)
+	case "POST /guide/theming/themes/theme-1/publish":
+		s.published = true
+		_, _ = io.WriteString(w, `{"theme": {"id": "theme-1", "live": true}}`)
+	case "GET /guide/theming/themes/theme-1":
+		if s.deleted {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		live := "false"
+		if s.published {
+			live = "true"
+		}
+		_, _ = io.WriteString(w, `{"theme": {"id": "theme-1", "name": "Skynet", "author": "Cyberdyne Systems", "version": "1.0.0", "live": `+live+`, "brand_id": "360001"}}`)
+	case "DELETE /guide/theming/themes/theme-1":
+		s.deleted = true
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		s.t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func fastHelpCenterThemeJobPolling(t *testing.T) {
+	interval := helpCenterThemeJobPollInterval
+	helpCenterThemeJobPollInterval = time.Millisecond
+	t.Cleanup(func() { helpCenterThemeJobPollInterval = interval })
+}
+
+func TestCreateHelpCenterThemeWithFakeServer(t *testing.T) {
+	fastHelpCenterThemeJobPolling(t)
+	s := newFakeThemingServer(t, "completed")
+	zd := s.client()
+
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"path":     "testdata/theme",
+			"brand_id": 360001,
+			"publish":  true,
+		},
+	}
+
+	if diags := createHelpCenterTheme(context.Background(), i, zd, time.Minute); len(diags) != 0 {
+		t.Fatalf("createHelpCenterTheme returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "theme-1" {
+		t.Fatalf("createHelpCenterTheme did not set resource id. Id was %s", v)
+	}
+
+	expected := []string{"manifest.json", "style.css", "templates/home_page.hbs"}
+	if strings.Join(s.uploaded, ",") != strings.Join(expected, ",") {
+		t.Fatalf("uploaded archive had %v. should have had %v", s.uploaded, expected)
+	}
+
+	if !s.published || i.Get("live") != true {
+		t.Fatalf("createHelpCenterTheme did not publish the theme")
+	}
+
+	hash, err := themeDirectoryHash("testdata/theme")
+	if err != nil {
+		t.Fatalf("themeDirectoryHash returned an error: %v", err)
+	}
+	if v := i.Get("content_hash"); v != hash {
+		t.Fatalf("createHelpCenterTheme set content_hash %v. should have been %s", v, hash)
+	}
+
+	if v := i.Get("name"); v != "Skynet" {
+		t.Fatalf("createHelpCenterTheme did not read the theme. name was %v", v)
+	}
+
+	if diags := deleteHelpCenterTheme(context.Background(), i, zd); len(diags) != 0 {
+		t.Fatalf("deleteHelpCenterTheme returned an error: %v", diags)
+	}
+
+	if diags := readHelpCenterTheme(context.Background(), i, zd); len(diags) != 0 || i.Id() != "" {
+		t.Fatalf("readHelpCenterTheme did not remove the deleted theme: %v", diags)
+	}
+}
+
+func TestCreateHelpCenterThemeFailedJob(t *testing.T) {
+	fastHelpCenterThemeJobPolling(t)
+	s := newFakeThemingServer(t, "failed")
+
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"path":     "testdata/theme",
+			"brand_id": 360001,
+		},
+	}
+
+	diags := createHelpCenterTheme(context.Background(), i, s.client(), time.Minute)
+	if len(diags) != 1 || !strings.Contains(diags[0].Summary, "Missing template (TemplateNotFound)") {
+		t.Fatalf("createHelpCenterTheme did not return the job errors: %v", diags)
+	}
+
+	if i.Id() != "" {
+		t.Fatalf("createHelpCenterTheme kept the theme of the failed job")
+	}
+}
+
+func TestReadHelpCenterThemeRepublishes(t *testing.T) {
+	s := newFakeThemingServer(t, "completed")
+
+	i := &identifiableMapGetterSetter{
+		id: "theme-1",
+		mapGetterSetter: mapGetterSetter{
+			"publish": true,
+		},
+	}
+
+	// another theme was published since
+	if diags := readHelpCenterTheme(context.Background(), i, s.client()); len(diags) != 0 {
+		t.Fatalf("readHelpCenterTheme returned an error: %v", diags)
+	}
+
+	if v := i.Get("publish"); v != false {
+		t.Fatalf("readHelpCenterTheme did not plan publishing the theme again. publish was %v", v)
+	}
+}
+
+func TestThemeDirectoryHash(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	hash := func() string {
+		h, err := themeDirectoryHash(dir)
+		if err != nil {
+			t.Fatalf("themeDirectoryHash returned an error: %v", err)
+		}
+		return h
+	}
+
+	write("manifest.json", "{}")
+	write("templates/home_page.hbs", "<h1></h1>")
+	initial := hash()
+
+	write(".git/HEAD", "ref: refs/heads/main")
+	write(".DS_Store", "")
+	if hash() != initial {
+		t.Fatal("themeDirectoryHash changed with ignored files")
+	}
+
+	write("templates/home_page.hbs", "<h2></h2>")
+	if hash() == initial {
+		t.Fatal("themeDirectoryHash did not change with the contents of a file")
+	}
+
+	if _, err := themeDirectoryHash(filepath.Join(dir, "missing")); !os.IsNotExist(err) {
+		t.Fatalf("themeDirectoryHash did not return a not exist error for a missing directory: %v", err)
+	}
+}
@@ -0,0 +1,329 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// helpCenterTranslationSources maps the translated source types to their endpoint names
+var helpCenterTranslationSources = map[string]string{
+	"article":  "articles",
+	"section":  "sections",
+	"category": "categories",
+}
+
+// https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/
+func resourceZendeskHelpCenterTranslation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a translation of a Help Center article, section or category. " +
+			"Import with the id `<source_type>/<source_id>/<locale>`, e.g. `article/360001234567/de`; " +
+			"the `zendesk_help_center_translations` data source lists the ids of the existing translations of a source.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return createHelpCenterTranslation(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return readHelpCenterTranslation(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return updateHelpCenterTranslation(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return deleteHelpCenterTranslation(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+				sourceType, sourceID, locale, err := parseHelpCenterTranslationID(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				err = setSchemaFields(d, map[string]interface{}{
+					"source_type": sourceType,
+					"source_id":   sourceID,
+					"locale":      locale,
+				})
+				return []*schema.ResourceData{d}, err
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"source_type": {
+				Description:  "The type of the translated item: `article`, `section` or `category`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"article", "section", "category"}, false),
+			},
+			"source_id": {
+				Description: "The id of the translated item.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"locale": {
+				Description: "The locale of the translation.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"title": {
+				Description: "The title of the translation, which is the name of sections and categories.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"body": {
+				Description: "The HTML body of the translation, which is the description of sections and categories.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
+			"draft": {
+				Description: "Whether the translation is a draft. Only applies to articles.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"outdated": {
+				Description: "Whether the translation is outdated. Also true when the translation in the source locale was updated after this one. " +
+					"Updating the title or body marks the translation up to date unless set.",
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"translation_id": {
+				Description: "The id of the translation in Zendesk.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"html_url": {
+				Description: "The url of the translation in Help Center.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"url": {
+				Description: "The API url of the translation.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func parseHelpCenterTranslationID(id string) (string, int64, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 || parts[2] == "" {
+		return "", 0, "", fmt.Errorf("could not parse help center translation id %s: should be <source_type>/<source_id>/<locale>", id)
+	}
+
+	if _, ok := helpCenterTranslationSources[parts[0]]; !ok {
+		return "", 0, "", fmt.Errorf("could not parse help center translation id %s: unknown source type %s", id, parts[0])
+	}
+
+	sourceID, err := atoi64(parts[1])
+	if err != nil {
+		return "", 0, "", fmt.Errorf("could not parse help center translation id %s: %v", id, err)
+	}
+
+	return parts[0], sourceID, parts[2], nil
+}
+
+// helpCenterTranslationSource returns the endpoint name and id of the translated item
+func helpCenterTranslationSource(d getter) (string, int64) {
+	return helpCenterTranslationSources[d.Get("source_type").(string)], int64(d.Get("source_id").(int))
+}
+
+// isHelpCenterTranslationOutdated reports whether the translation in the source locale of the item
+// was updated after the translation. Translations in the source locale are never outdated.
+func isHelpCenterTranslationOutdated(ctx context.Context, zd client.BaseAPI, sources string, sourceID int64, translation helpCenterTranslation) (bool, error) {
+	var source map[string]struct {
+		SourceLocale string `json:"source_locale"`
+	}
+	err := getJSON(ctx, zd, fmt.Sprintf("/help_center/%s/%d.json", sources, sourceID), &source)
+	if err != nil {
+		return false, err
+	}
+
+	var sourceLocale string
+	for _, s := range source {
+		sourceLocale = s.SourceLocale
+	}
+	if sourceLocale == "" || strings.EqualFold(sourceLocale, translation.Locale) || translation.UpdatedAt == nil {
+		return false, nil
+	}
+
+	var result struct {
+		Translation helpCenterTranslation `json:"translation"`
+	}
+	err = getJSON(ctx, zd, fmt.Sprintf("/help_center/%s/%d/translations/%s.json", sources, sourceID, sourceLocale), &result)
+	if err != nil {
+		return false, err
+	}
+
+	return result.Translation.UpdatedAt != nil && result.Translation.UpdatedAt.After(*translation.UpdatedAt), nil
+}
+
+// helpCenterTranslationOutdatedConfigured reports whether the outdated flag is set in the configuration
+func helpCenterTranslationOutdatedConfigured(d getter) bool {
+	if c, ok := d.(interface{ GetRawConfig() cty.Value }); ok {
+		config := c.GetRawConfig()
+		return !config.IsNull() && config.IsKnown() && !config.GetAttr("outdated").IsNull()
+	}
+
+	_, ok := d.GetOk("outdated")
+	return ok
+}
+
+func marshalHelpCenterTranslation(translation helpCenterTranslation, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"locale":         translation.Locale,
+		"title":          translation.Title,
+		"body":           translation.Body,
+		"draft":          translation.Draft,
+		"outdated":       translation.Outdated,
+		"translation_id": translation.ID,
+		"html_url":       translation.HTMLURL,
+		"url":            translation.URL,
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+func unmarshalHelpCenterTranslation(d identifiableGetterSetter) (helpCenterTranslation, error) {
+	translation := helpCenterTranslation{}
+
+	if v, ok := d.GetOk("translation_id"); ok {
+		translation.ID = int64(v.(int))
+	}
+
+	if v, ok := d.GetOk("locale"); ok {
+		translation.Locale = v.(string)
+	}
+
+	if v, ok := d.GetOk("title"); ok {
+		translation.Title = v.(string)
+	}
+
+	if v, ok := d.GetOk("body"); ok {
+		translation.Body = v.(string)
+	}
+
+	if v, ok := d.GetOk("draft"); ok {
+		translation.Draft = v.(bool)
+	}
+
+	if v, ok := d.GetOk("outdated"); ok {
+		translation.Outdated = v.(bool)
+	}
+
+	return translation, nil
+}
+
+func createHelpCenterTranslation(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	translation, err := unmarshalHelpCenterTranslation(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sources, sourceID := helpCenterTranslationSource(d)
+
+	var data, result struct {
+		Translation helpCenterTranslation `json:"translation"`
+	}
+	data.Translation = translation
+
+	err = postJSON(ctx, zd, fmt.Sprintf("/help_center/%s/%d/translations.json", sources, sourceID), data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d/%s", d.Get("source_type").(string), sourceID, result.Translation.Locale))
+
+	err = marshalHelpCenterTranslation(result.Translation, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readHelpCenterTranslation(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	sources, sourceID := helpCenterTranslationSource(d)
+
+	var result struct {
+		Translation helpCenterTranslation `json:"translation"`
+	}
+	err := getJSON(ctx, zd, fmt.Sprintf("/help_center/%s/%d/translations/%s.json", sources, sourceID, d.Get("locale").(string)), &result)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	translation := result.Translation
+	if !translation.Outdated {
+		translation.Outdated, err = isHelpCenterTranslationOutdated(ctx, zd, sources, sourceID, translation)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err = marshalHelpCenterTranslation(translation, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateHelpCenterTranslation(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	translation, err := unmarshalHelpCenterTranslation(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// new content brings the translation up to date
+	if c, ok := d.(interface{ HasChanges(...string) bool }); ok && c.HasChanges("title", "body") && !helpCenterTranslationOutdatedConfigured(d) {
+		translation.Outdated = false
+	}
+
+	sources, sourceID := helpCenterTranslationSource(d)
+
+	err = putHelpCenterTranslation(ctx, zd, sources, sourceID, translation.Locale, translation)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return readHelpCenterTranslation(ctx, d, zd)
+}
+
+func deleteHelpCenterTranslation(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, ok := d.GetOk("translation_id")
+	if !ok {
+		return diags
+	}
+
+	err := zd.Delete(ctx, fmt.Sprintf("/help_center/translations/%d.json", id.(int)))
+	if err != nil && !isNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
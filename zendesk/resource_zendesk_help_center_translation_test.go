@@ -0,0 +1,234 @@
+package zendesk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestParseHelpCenterTranslationID(t *testing.T) {
+	sourceType, sourceID, locale, err := parseHelpCenterTranslationID("article/4567/pt-br")
+	if err != nil {
+		t.Fatalf("parseHelpCenterTranslationID returned an error: %v", err)
+	}
+
+	if sourceType != "article" || sourceID != 4567 || locale != "pt-br" {
+		t.Fatalf("parseHelpCenterTranslationID returned %s, %d and %s", sourceType, sourceID, locale)
+	}
+
+	for _, id := range []string{"article/4567", "articles/4567/de", "article/t800/de", "article/4567/"} {
+		if _, _, _, err := parseHelpCenterTranslationID(id); err == nil {
+			t.Fatalf("parseHelpCenterTranslationID did not return an error for %s", id)
+		}
+	}
+}
+
+func TestCreateHelpCenterTranslation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"source_type": "section",
+			"source_id":   3571,
+			"locale":      "de",
+			"title":       "Wartung",
+		},
+	}
+	out := []byte(`{"translation": {"id": 9876, "source_id": 3571, "source_type": "Section", "locale": "de", "title": "Wartung", "body": ""}}`)
+
+	m.EXPECT().Post(gomock.Any(), gomock.Eq("/help_center/sections/3571/translations.json"), gomock.Any()).Return(out, nil)
+	if diags := createHelpCenterTranslation(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createHelpCenterTranslation returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "section/3571/de" {
+		t.Fatalf("createHelpCenterTranslation did not set resource id. Id was %s", v)
+	}
+
+	if v := i.Get("translation_id"); v != int64(9876) {
+		t.Fatalf("createHelpCenterTranslation did not set translation_id. translation_id was %v", v)
+	}
+}
+
+func TestReadHelpCenterTranslationDetectsOutdated(t *testing.T) {
+	cases := []struct {
+		name          string
+		sourceUpdated string
+		outdated      bool
+	}{
+		{"source updated before", "2026-01-01T00:00:00Z", false},
+		{"source updated after", "2026-03-01T00:00:00Z", true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mock.NewClient(ctrl)
+			i := &identifiableMapGetterSetter{
+				id: "article/4567/de",
+				mapGetterSetter: mapGetterSetter{
+					"source_type": "article",
+					"source_id":   4567,
+					"locale":      "de",
+				},
+			}
+
+			m.EXPECT().Get(gomock.Any(), gomock.Eq("/help_center/articles/4567/translations/de.json")).
+				Return([]byte(`{"translation": {"id": 9876, "locale": "de", "title": "Einen T-800 zurücksetzen", "outdated": false, "updated_at": "2026-02-01T00:00:00Z"}}`), nil)
+			m.EXPECT().Get(gomock.Any(), gomock.Eq("/help_center/articles/4567.json")).
+				Return([]byte(`{"article": {"id": 4567, "source_locale": "en-us"}}`), nil)
+			m.EXPECT().Get(gomock.Any(), gomock.Eq("/help_center/articles/4567/translations/en-us.json")).
+				Return([]byte(`{"translation": {"id": 9875, "locale": "en-us", "updated_at": "`+c.sourceUpdated+`"}}`), nil)
+
+			if diags := readHelpCenterTranslation(context.Background(), i, m); len(diags) != 0 {
+				t.Fatalf("readHelpCenterTranslation returned an error: %v", diags)
+			}
+
+			if v := i.Get("outdated"); v != c.outdated {
+				t.Fatalf("readHelpCenterTranslation set outdated to %v. should have been %v", v, c.outdated)
+			}
+		})
+	}
+}
+
+func TestReadHelpCenterTranslationInSourceLocale(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "category/1635/en-us",
+		mapGetterSetter: mapGetterSetter{
+			"source_type": "category",
+			"source_id":   1635,
+			"locale":      "en-us",
+		},
+	}
+
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/help_center/categories/1635/translations/en-us.json")).
+		Return([]byte(`{"translation": {"id": 9870, "locale": "en-us", "title": "Cyberdyne Systems", "updated_at": "2026-02-01T00:00:00Z"}}`), nil)
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/help_center/categories/1635.json")).
+		Return([]byte(`{"category": {"id": 1635, "source_locale": "en-us"}}`), nil)
+
+	if diags := readHelpCenterTranslation(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readHelpCenterTranslation returned an error: %v", diags)
+	}
+
+	if v := i.Get("outdated"); v != false {
+		t.Fatalf("readHelpCenterTranslation marked the source translation outdated")
+	}
+}
+
+func TestUpdateHelpCenterTranslationClearsOutdated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := rawConfigGetterSetter{
+		changeGetterSetter: changeGetterSetter{
+			identifiableMapGetterSetter: &identifiableMapGetterSetter{
+				id: "article/4567/de",
+				mapGetterSetter: mapGetterSetter{
+					"source_type":    "article",
+					"source_id":      4567,
+					"locale":         "de",
+					"title":          "Einen T-800 zurücksetzen",
+					"body":           "<p>Neu übersetzt.</p>",
+					"outdated":       true,
+					"translation_id": 9876,
+				},
+			},
+			old: mapGetterSetter{
+				"title": "Einen T-800 zurücksetzen",
+				"body":  "<p>Alt.</p>",
+			},
+		},
+		// outdated is true in the state only
+		config: cty.ObjectVal(map[string]cty.Value{
+			"outdated": cty.NullVal(cty.Bool),
+		}),
+	}
+
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/help_center/articles/4567/translations/de.json"), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, path string, data interface{}) ([]byte, error) {
+			tr := data.(struct {
+				Translation helpCenterTranslation `json:"translation"`
+			}).Translation
+			if tr.Outdated || tr.Body != "<p>Neu übersetzt.</p>" {
+				t.Fatalf("updated translation was %v", tr)
+			}
+			return []byte(`{"translation": {}}`), nil
+		})
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/help_center/articles/4567/translations/de.json")).
+		Return([]byte(`{"translation": {"id": 9876, "locale": "de", "title": "Einen T-800 zurücksetzen", "body": "<p>Neu übersetzt.</p>", "updated_at": "2026-03-02T00:00:00Z"}}`), nil)
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/help_center/articles/4567.json")).
+		Return([]byte(`{"article": {"id": 4567, "source_locale": "en-us"}}`), nil)
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/help_center/articles/4567/translations/en-us.json")).
+		Return([]byte(`{"translation": {"id": 9875, "locale": "en-us", "updated_at": "2026-03-01T00:00:00Z"}}`), nil)
+
+	if diags := updateHelpCenterTranslation(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateHelpCenterTranslation returned an error: %v", diags)
+	}
+
+	if v := i.Get("outdated"); v != false {
+		t.Fatalf("updateHelpCenterTranslation did not clear outdated")
+	}
+}
+
+func TestDeleteHelpCenterTranslation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "article/4567/de",
+		mapGetterSetter: mapGetterSetter{
+			"translation_id": 9876,
+		},
+	}
+
+	m.EXPECT().Delete(gomock.Any(), gomock.Eq("/help_center/translations/9876.json")).Return(nil)
+	if diags := deleteHelpCenterTranslation(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteHelpCenterTranslation returned an error: %v", diags)
+	}
+}
+
+func TestAccHelpCenterTranslationExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testHelpCenterDestroyed("zendesk_help_center_category", "/help_center/categories/%s.json"),
+		Steps: []resource.TestStep{
+			{
+				Config: concatExampleConfig(t,
+					readExampleConfig(t, "resources/zendesk_help_center_category/resource.tf"),
+					readExampleConfig(t, "resources/zendesk_help_center_section/resource.tf"),
+					readExampleConfig(t, "resources/zendesk_help_center_user_segment/resource.tf"),
+					readExampleConfig(t, "resources/zendesk_help_center_permission_group/resource.tf"),
+					readExampleConfig(t, "resources/zendesk_help_center_article/resource.tf"),
+					readExampleConfig(t, "resources/zendesk_help_center_translation/resource.tf"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zendesk_help_center_translation.t800-reset-de", "locale", "de"),
+					resource.TestCheckResourceAttr("zendesk_help_center_translation.t800-reset-de", "outdated", "false"),
+					resource.TestCheckResourceAttrSet("zendesk_help_center_translation.t800-reset-de", "translation_id"),
+				),
+			},
+			{
+				ResourceName:      "zendesk_help_center_translation.t800-reset-de",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,268 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// helpCenterUserSegment is the Help Center user segment JSON payload format
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/user_segments/#json-format
+type helpCenterUserSegment struct {
+	ID              int64    `json:"id,omitempty"`
+	Name            string   `json:"name"`
+	UserType        string   `json:"user_type"`
+	GroupIDs        []int64  `json:"group_ids"`
+	OrganizationIDs []int64  `json:"organization_ids"`
+	Tags            []string `json:"tags"`
+	OrTags          []string `json:"or_tags"`
+	BuiltIn         bool     `json:"built_in,omitempty"`
+}
+
+// https://developer.zendesk.com/api-reference/help_center/help-center-api/user_segments/
+func resourceZendeskHelpCenterUserSegment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Help Center user segment resource. User segments define who can see articles and who can edit and publish them through permission groups.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return createHelpCenterUserSegment(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return readHelpCenterUserSegment(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return updateHelpCenterUserSegment(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return deleteHelpCenterUserSegment(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the user segment.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"user_type": {
+				Description: "The type of users in the segment: all signed in users (`signed_in_users`) or agents and admins only (`staff`).",
+				Type:        schema.TypeString,
+				Required:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"signed_in_users",
+					"staff",
+				}, false),
+			},
+			"group_ids": {
+				Description: "The ids of the groups users must belong to. Users must belong to at least one of them.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Optional: true,
+			},
+			"organization_ids": {
+				Description: "The ids of the organizations users must belong to. Users must belong to at least one of them.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Optional: true,
+			},
+			"tags": {
+				Description: "Tags users must have all of.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+			},
+			"or_tags": {
+				Description: "Tags users must have at least one of.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+			},
+			"built_in": {
+				Description: "Whether the user segment is built in. Built in user segments can't be modified.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func marshalHelpCenterUserSegment(segment helpCenterUserSegment, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"name":             segment.Name,
+		"user_type":        segment.UserType,
+		"group_ids":        segment.GroupIDs,
+		"organization_ids": segment.OrganizationIDs,
+		"tags":             segment.Tags,
+		"or_tags":          segment.OrTags,
+		"built_in":         segment.BuiltIn,
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+func unmarshalHelpCenterUserSegment(d identifiableGetterSetter) (helpCenterUserSegment, error) {
+	segment := helpCenterUserSegment{
+		GroupIDs:        []int64{},
+		OrganizationIDs: []int64{},
+		Tags:            []string{},
+		OrTags:          []string{},
+	}
+
+	if v := d.Id(); v != "" {
+		id, err := atoi64(v)
+		if err != nil {
+			return segment, fmt.Errorf("could not parse help center user segment id %s: %v", v, err)
+		}
+		segment.ID = id
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		segment.Name = v.(string)
+	}
+
+	if v, ok := d.GetOk("user_type"); ok {
+		segment.UserType = v.(string)
+	}
+
+	if v, ok := d.GetOk("group_ids"); ok {
+		for _, id := range v.(*schema.Set).List() {
+			segment.GroupIDs = append(segment.GroupIDs, int64(id.(int)))
+		}
+	}
+
+	if v, ok := d.GetOk("organization_ids"); ok {
+		for _, id := range v.(*schema.Set).List() {
+			segment.OrganizationIDs = append(segment.OrganizationIDs, int64(id.(int)))
+		}
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		for _, tag := range v.(*schema.Set).List() {
+			segment.Tags = append(segment.Tags, tag.(string))
+		}
+	}
+
+	if v, ok := d.GetOk("or_tags"); ok {
+		for _, tag := range v.(*schema.Set).List() {
+			segment.OrTags = append(segment.OrTags, tag.(string))
+		}
+	}
+
+	return segment, nil
+}
+
+func createHelpCenterUserSegment(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	segment, err := unmarshalHelpCenterUserSegment(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var data, result struct {
+		UserSegment helpCenterUserSegment `json:"user_segment"`
+	}
+	data.UserSegment = segment
+
+	err = postJSON(ctx, zd, "/help_center/user_segments.json", data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", result.UserSegment.ID))
+
+	err = marshalHelpCenterUserSegment(result.UserSegment, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readHelpCenterUserSegment(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result struct {
+		UserSegment helpCenterUserSegment `json:"user_segment"`
+	}
+	err = getJSON(ctx, zd, fmt.Sprintf("/help_center/user_segments/%d.json", id), &result)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalHelpCenterUserSegment(result.UserSegment, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateHelpCenterUserSegment(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	segment, err := unmarshalHelpCenterUserSegment(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var data, result struct {
+		UserSegment helpCenterUserSegment `json:"user_segment"`
+	}
+	data.UserSegment = segment
+
+	err = putJSON(ctx, zd, fmt.Sprintf("/help_center/user_segments/%d.json", segment.ID), data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalHelpCenterUserSegment(result.UserSegment, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func deleteHelpCenterUserSegment(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = zd.Delete(ctx, fmt.Sprintf("/help_center/user_segments/%d.json", id))
+	if err != nil && !isNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,141 @@
+package zendesk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestUnmarshalHelpCenterUserSegment(t *testing.T) {
+	i := &identifiableMapGetterSetter{
+		id: "8901",
+		mapGetterSetter: mapGetterSetter{
+			"name":      "Resistance members",
+			"user_type": "signed_in_users",
+			"group_ids": schema.NewSet(schema.HashInt, []interface{}{12}),
+			"tags":      schema.NewSet(schema.HashString, []interface{}{"resistance"}),
+		},
+	}
+
+	segment, err := unmarshalHelpCenterUserSegment(i)
+	if err != nil {
+		t.Fatalf("unmarshal returned an error: %v", err)
+	}
+
+	if segment.ID != 8901 || segment.UserType != "signed_in_users" {
+		t.Fatalf("user segment had id %d and user type %s", segment.ID, segment.UserType)
+	}
+
+	if len(segment.GroupIDs) != 1 || segment.GroupIDs[0] != 12 {
+		t.Fatalf("user segment had group ids %v", segment.GroupIDs)
+	}
+
+	// unset conditions are sent empty so that updates clear them
+	if segment.OrganizationIDs == nil || segment.OrTags == nil {
+		t.Fatalf("user segment had nil conditions: organization ids %v, or tags %v", segment.OrganizationIDs, segment.OrTags)
+	}
+}
+
+func TestCreateHelpCenterUserSegment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"name":      "Resistance members",
+			"user_type": "signed_in_users",
+			"or_tags":   schema.NewSet(schema.HashString, []interface{}{"tech-com", "command"}),
+		},
+	}
+	out := []byte(`{"user_segment": {"id": 8901, "name": "Resistance members", "user_type": "signed_in_users", "group_ids": [], "organization_ids": [], "tags": [], "or_tags": ["command", "tech-com"], "built_in": false}}`)
+
+	m.EXPECT().Post(gomock.Any(), gomock.Eq("/help_center/user_segments.json"), gomock.Any()).Return(out, nil)
+	if diags := createHelpCenterUserSegment(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createHelpCenterUserSegment returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "8901" {
+		t.Fatalf("createHelpCenterUserSegment did not set resource id. Id was %s", v)
+	}
+}
+
+func TestReadHelpCenterUserSegmentNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("8901")
+
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/help_center/user_segments/8901.json")).Return(nil, notFoundError())
+	if diags := readHelpCenterUserSegment(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readHelpCenterUserSegment returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "" {
+		t.Fatalf("readHelpCenterUserSegment did not remove the deleted user segment. Id was %s", v)
+	}
+}
+
+func TestUpdateHelpCenterUserSegment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "8901",
+		mapGetterSetter: mapGetterSetter{
+			"name":      "Cyberdyne engineers",
+			"user_type": "staff",
+		},
+	}
+	out := []byte(`{"user_segment": {"id": 8901, "name": "Cyberdyne engineers", "user_type": "staff", "group_ids": [], "organization_ids": [], "tags": [], "or_tags": []}}`)
+
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/help_center/user_segments/8901.json"), gomock.Any()).Return(out, nil)
+	if diags := updateHelpCenterUserSegment(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateHelpCenterUserSegment returned an error: %v", diags)
+	}
+
+	if v := i.Get("user_type"); v != "staff" {
+		t.Fatalf("updateHelpCenterUserSegment did not set user_type. user_type was %v", v)
+	}
+}
+
+func TestDeleteHelpCenterUserSegment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("8901")
+
+	m.EXPECT().Delete(gomock.Any(), gomock.Eq("/help_center/user_segments/8901.json")).Return(nil)
+	if diags := deleteHelpCenterUserSegment(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteHelpCenterUserSegment returned an error: %v", diags)
+	}
+}
+
+func TestAccHelpCenterUserSegmentExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testHelpCenterDestroyed("zendesk_help_center_user_segment", "/help_center/user_segments/%s.json"),
+		Steps: []resource.TestStep{
+			{
+				Config: readExampleConfig(t, "resources/zendesk_help_center_user_segment/resource.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zendesk_help_center_user_segment.resistance", "user_type", "signed_in_users"),
+					resource.TestCheckResourceAttr("zendesk_help_center_user_segment.resistance", "or_tags.#", "2"),
+					resource.TestCheckResourceAttr("zendesk_help_center_user_segment.cyberdyne-engineers", "user_type", "staff"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,182 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// The id of the account wide zendesk_ip_restriction resource
+const ipRestrictionID = "account"
+
+// accountSecurity is the IP restriction part of the security account settings JSON payload format
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/account_settings/#json-format
+type accountSecurity struct {
+	IPRestrictionEnabled bool   `json:"ip_restriction_enabled"`
+	AllowedIPRanges      string `json:"allowed_ip_ranges"`
+	EndUserBypass        bool   `json:"ip_restriction_end_user_bypass"`
+}
+
+// https://support.zendesk.com/hc/en-us/articles/4408887680794
+func resourceZendeskIPRestriction() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the IP restriction allowlist of the account. There is a single instance per account, imported with the id `account`. " +
+			"Destroying the resource disables the IP restriction.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return setIPRestriction(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return readIPRestriction(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return setIPRestriction(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return deleteIPRestriction(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+				if d.Id() != ipRestrictionID {
+					return nil, fmt.Errorf("could not import IP restriction %s: the id should be %s", d.Id(), ipRestrictionID)
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Description: "Whether only requests from `allowed_ranges` can access the account.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"allowed_ranges": {
+				Description: "The IP addresses and CIDR ranges allowed to access the account. Make sure the ranges you apply from are included.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: isValidIPRange(),
+				},
+				Required: true,
+				MinItems: 1,
+			},
+			"end_user_bypass": {
+				Description: "Whether end users can access the Help Center and submit requests from outside of `allowed_ranges`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func marshalIPRestriction(security accountSecurity, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"enabled":         security.IPRestrictionEnabled,
+		"allowed_ranges":  strings.Fields(security.AllowedIPRanges),
+		"end_user_bypass": security.EndUserBypass,
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+func unmarshalIPRestriction(d identifiableGetterSetter) accountSecurity {
+	security := accountSecurity{
+		IPRestrictionEnabled: d.Get("enabled").(bool),
+		EndUserBypass:        d.Get("end_user_bypass").(bool),
+	}
+
+	var ranges []string
+	if v, ok := d.GetOk("allowed_ranges"); ok {
+		for _, r := range v.(*schema.Set).List() {
+			ranges = append(ranges, r.(string))
+		}
+	}
+	sort.Strings(ranges)
+
+	// the ranges are sent separated by spaces, as entered in the admin center
+	security.AllowedIPRanges = strings.Join(ranges, " ")
+
+	return security
+}
+
+func putIPRestriction(ctx context.Context, zd client.BaseAPI, security accountSecurity) (accountSecurity, error) {
+	data := map[string]interface{}{
+		"settings": map[string]interface{}{
+			"security": security,
+		},
+	}
+
+	var result struct {
+		Settings struct {
+			Security accountSecurity `json:"security"`
+		} `json:"settings"`
+	}
+	err := putJSON(ctx, zd, "/account/settings.json", data, &result)
+	return result.Settings.Security, err
+}
+
+// setIPRestriction updates the IP restriction settings of the account
+func setIPRestriction(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	security, err := putIPRestriction(ctx, zd, unmarshalIPRestriction(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(ipRestrictionID)
+
+	err = marshalIPRestriction(security, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readIPRestriction(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var result struct {
+		Settings struct {
+			Security accountSecurity `json:"security"`
+		} `json:"settings"`
+	}
+	err := getJSON(ctx, zd, "/account/settings.json", &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalIPRestriction(result.Settings.Security, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// deleteIPRestriction disables the IP restriction, keeping the allowed ranges so it can be enabled again in the admin center
+func deleteIPRestriction(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	security := unmarshalIPRestriction(d)
+	security.IPRestrictionEnabled = false
+
+	_, err := putIPRestriction(ctx, zd, security)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,92 @@
+package zendesk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+// There is no acceptance test, as applying the IP restriction would lock the tests out of the account.
+
+func TestSetIPRestriction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"enabled":         true,
+			"allowed_ranges":  schema.NewSet(schema.HashString, []interface{}{"198.51.100.17", "192.0.2.0/24"}),
+			"end_user_bypass": true,
+		},
+	}
+
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/account/settings.json"), gomock.Eq(map[string]interface{}{
+		"settings": map[string]interface{}{
+			"security": accountSecurity{
+				IPRestrictionEnabled: true,
+				AllowedIPRanges:      "192.0.2.0/24 198.51.100.17",
+				EndUserBypass:        true,
+			},
+		},
+	})).Return([]byte(`{"settings": {"security": {"ip_restriction_enabled": true, "allowed_ip_ranges": "192.0.2.0/24 198.51.100.17", "ip_restriction_end_user_bypass": true}}}`), nil)
+
+	if diags := setIPRestriction(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("setIPRestriction returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "account" {
+		t.Fatalf("setIPRestriction did not set resource id. Id was %s", v)
+	}
+}
+
+func TestReadIPRestriction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("account")
+
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/account/settings.json")).
+		Return([]byte(`{"settings": {"security": {"ip_restriction_enabled": true, "allowed_ip_ranges": "192.0.2.0/24  198.51.100.17", "ip_restriction_end_user_bypass": false}}}`), nil)
+
+	if diags := readIPRestriction(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readIPRestriction returned an error: %v", diags)
+	}
+
+	if v := i.Get("allowed_ranges").([]string); len(v) != 2 || v[0] != "192.0.2.0/24" || v[1] != "198.51.100.17" {
+		t.Fatalf("readIPRestriction set allowed_ranges %v", v)
+	}
+}
+
+func TestDeleteIPRestrictionDisables(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "account",
+		mapGetterSetter: mapGetterSetter{
+			"enabled":         true,
+			"allowed_ranges":  schema.NewSet(schema.HashString, []interface{}{"192.0.2.0/24"}),
+			"end_user_bypass": false,
+		},
+	}
+
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/account/settings.json"), gomock.Eq(map[string]interface{}{
+		"settings": map[string]interface{}{
+			"security": accountSecurity{
+				IPRestrictionEnabled: false,
+				AllowedIPRanges:      "192.0.2.0/24",
+			},
+		},
+	})).Return([]byte(`{"settings": {"security": {"ip_restriction_enabled": false, "allowed_ip_ranges": "192.0.2.0/24"}}}`), nil)
+
+	if diags := deleteIPRestriction(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteIPRestriction returned an error: %v", diags)
+	}
+}
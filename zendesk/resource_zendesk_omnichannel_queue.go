@@ -0,0 +1,350 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// omnichannelQueueCondition is a condition of the definition of an omnichannel routing queue.
+// Values are either strings or lists, e.g. of routing attribute value ids.
+type omnichannelQueueCondition struct {
+	Field    string      `json:"field"`
+	Operator string      `json:"operator"`
+	Value    interface{} `json:"value"`
+}
+
+// omnichannelQueueGroups is the format of the groups of an omnichannel routing queue in responses
+type omnichannelQueueGroups struct {
+	Groups []struct {
+		ID int64 `json:"id"`
+	} `json:"groups"`
+}
+
+// omnichannelQueue is the omnichannel routing queue JSON payload format.
+// The groups are sent as ids and returned as objects.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/omnichannel/omnichannel_routing_queues/#json-format
+type omnichannelQueue struct {
+	ID                string                  `json:"id,omitempty"`
+	URL               string                  `json:"url,omitempty"`
+	Name              string                  `json:"name"`
+	Description       string                  `json:"description"`
+	Priority          int64                   `json:"priority"`
+	Order             int64                   `json:"order,omitempty"`
+	PrimaryGroupIDs   []int64                 `json:"primary_groups_id,omitempty"`
+	SecondaryGroupIDs []int64                 `json:"secondary_groups_id"`
+	PrimaryGroups     *omnichannelQueueGroups `json:"primary_groups,omitempty"`
+	SecondaryGroups   *omnichannelQueueGroups `json:"secondary_groups,omitempty"`
+	Definition        struct {
+		All []omnichannelQueueCondition `json:"all"`
+		Any []omnichannelQueueCondition `json:"any"`
+	} `json:"definition"`
+}
+
+// https://developer.zendesk.com/api-reference/ticketing/omnichannel/omnichannel_routing_queues/
+func resourceZendeskOmnichannelQueue() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides an omnichannel routing queue, routing the work matching its definition to its groups.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return createOmnichannelQueue(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return readOmnichannelQueue(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return updateOmnichannelQueue(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return deleteOmnichannelQueue(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the queue.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The description of the queue.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+			},
+			"priority": {
+				Description:  "The priority of the work in the queue. Work from queues with a higher priority is routed first.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"order": {
+				Description:  "The position of the queue among the queues of the account. Work is added to the first queue whose definition matches. Set by Zendesk when omitted.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"primary_group_ids": {
+				Description: "The ids of the groups the work is routed to first.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Required: true,
+				MinItems: 1,
+			},
+			"secondary_group_ids": {
+				Description: "The ids of the groups the work is routed to when no agent of the primary groups is available.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Optional: true,
+			},
+			// Both the "all" and "any" parameter are optional, but at least one of them must be supplied
+			"all": triggerConditionSchema("Logical AND. All the conditions must be met. List values, e.g. of routing attribute value ids, are JSON encoded."),
+			"any": triggerConditionSchema("Logical OR. Any condition can be met. List values, e.g. of routing attribute value ids, are JSON encoded."),
+			"url": {
+				Description: "The API url of the queue.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func marshalOmnichannelQueueConditions(conditions []omnichannelQueueCondition) ([]map[string]interface{}, error) {
+	var result []map[string]interface{}
+	for _, c := range conditions {
+		// If the condition value is a string, leave it be
+		// Otherwise marshal it to a string
+		value, ok := c.Value.(string)
+		if !ok {
+			tmp, err := json.Marshal(c.Value)
+			if err != nil {
+				return nil, fmt.Errorf("error decoding queue condition value: %s", err)
+			}
+			value = string(tmp)
+		}
+
+		result = append(result, map[string]interface{}{
+			"field":    c.Field,
+			"operator": c.Operator,
+			"value":    value,
+		})
+	}
+	return result, nil
+}
+
+func unmarshalOmnichannelQueueConditions(d getter, key string) ([]omnichannelQueueCondition, error) {
+	conditions := []omnichannelQueueCondition{}
+
+	v, ok := d.GetOk(key)
+	if !ok {
+		return conditions, nil
+	}
+
+	for _, c := range v.(*schema.Set).List() {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("could not parse '%s' conditions for queue", key)
+		}
+
+		// If the condition value is a list, unmarshal it
+		var value interface{} = condition["value"].(string)
+		if strings.HasPrefix(condition["value"].(string), "[") {
+			err := json.Unmarshal([]byte(condition["value"].(string)), &value)
+			if err != nil {
+				return nil, fmt.Errorf("error unmarshalling queue condition value: %s", err)
+			}
+		}
+
+		conditions = append(conditions, omnichannelQueueCondition{
+			Field:    condition["field"].(string),
+			Operator: condition["operator"].(string),
+			Value:    value,
+		})
+	}
+
+	return conditions, nil
+}
+
+func omnichannelQueueGroupIDs(groups *omnichannelQueueGroups) []int64 {
+	ids := make([]int64, 0)
+	if groups == nil {
+		return ids
+	}
+	for _, g := range groups.Groups {
+		ids = append(ids, g.ID)
+	}
+	return ids
+}
+
+func unmarshalOmnichannelQueueGroupIDs(d getter, key string) []int64 {
+	ids := make([]int64, 0)
+	if v, ok := d.GetOk(key); ok {
+		for _, id := range v.(*schema.Set).List() {
+			ids = append(ids, int64(id.(int)))
+		}
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func marshalOmnichannelQueue(queue omnichannelQueue, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"name":                queue.Name,
+		"description":         queue.Description,
+		"priority":            queue.Priority,
+		"order":               queue.Order,
+		"primary_group_ids":   omnichannelQueueGroupIDs(queue.PrimaryGroups),
+		"secondary_group_ids": omnichannelQueueGroupIDs(queue.SecondaryGroups),
+		"url":                 queue.URL,
+	}
+
+	alls, err := marshalOmnichannelQueueConditions(queue.Definition.All)
+	if err != nil {
+		return err
+	}
+	fields["all"] = alls
+
+	anys, err := marshalOmnichannelQueueConditions(queue.Definition.Any)
+	if err != nil {
+		return err
+	}
+	fields["any"] = anys
+
+	return setSchemaFields(d, fields)
+}
+
+func unmarshalOmnichannelQueue(d identifiableGetterSetter) (omnichannelQueue, error) {
+	queue := omnichannelQueue{
+		ID:                d.Id(),
+		Name:              d.Get("name").(string),
+		Description:       d.Get("description").(string),
+		Priority:          int64(d.Get("priority").(int)),
+		PrimaryGroupIDs:   unmarshalOmnichannelQueueGroupIDs(d, "primary_group_ids"),
+		SecondaryGroupIDs: unmarshalOmnichannelQueueGroupIDs(d, "secondary_group_ids"),
+	}
+
+	if v, ok := d.GetOk("order"); ok {
+		queue.Order = int64(v.(int))
+	}
+
+	var err error
+	queue.Definition.All, err = unmarshalOmnichannelQueueConditions(d, "all")
+	if err != nil {
+		return queue, err
+	}
+
+	queue.Definition.Any, err = unmarshalOmnichannelQueueConditions(d, "any")
+	if err != nil {
+		return queue, err
+	}
+
+	return queue, nil
+}
+
+func createOmnichannelQueue(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	queue, err := unmarshalOmnichannelQueue(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var data, result struct {
+		Queue omnichannelQueue `json:"queue"`
+	}
+	data.Queue = queue
+
+	err = postJSON(ctx, zd, "/queues.json", data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(result.Queue.ID)
+
+	err = marshalOmnichannelQueue(result.Queue, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readOmnichannelQueue(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var result struct {
+		Queue omnichannelQueue `json:"queue"`
+	}
+	err := getJSON(ctx, zd, fmt.Sprintf("/queues/%s.json", d.Id()), &result)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalOmnichannelQueue(result.Queue, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateOmnichannelQueue(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	queue, err := unmarshalOmnichannelQueue(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var data, result struct {
+		Queue omnichannelQueue `json:"queue"`
+	}
+	data.Queue = queue
+
+	err = patchJSON(ctx, zd, fmt.Sprintf("/queues/%s.json", d.Id()), data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalOmnichannelQueue(result.Queue, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func deleteOmnichannelQueue(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := zd.Delete(ctx, fmt.Sprintf("/queues/%s.json", d.Id()))
+	if err != nil && !isNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
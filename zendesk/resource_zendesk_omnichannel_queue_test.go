@@ -0,0 +1,188 @@
+package zendesk
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestCreateOmnichannelQueue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"name":                "Urgent",
+			"description":         "",
+			"priority":            2,
+			"primary_group_ids":   schema.NewSet(schema.HashInt, []interface{}{8, 3}),
+			"secondary_group_ids": schema.NewSet(schema.HashInt, []interface{}{}),
+			"all": schema.NewSet(schema.HashResource(triggerConditionSchema("").Elem.(*schema.Resource)), []interface{}{
+				map[string]interface{}{
+					"field":    "priority",
+					"operator": "is",
+					"value":    "urgent",
+				},
+				map[string]interface{}{
+					"field":    "routing_attribute_value_ids",
+					"operator": "includes_any",
+					"value":    `["b376b35a"]`,
+				},
+			}),
+		},
+	}
+	out := []byte(`{"queue": {
+		"id": "01HG80ATNNZK1N7XRFVKX48XD6",
+		"name": "Urgent",
+		"description": "",
+		"priority": 2,
+		"order": 3,
+		"primary_groups": {"count": 2, "groups": [{"id": 3, "name": "Moderator"}, {"id": 8, "name": "Developer"}]},
+		"secondary_groups": {"count": 0, "groups": []},
+		"definition": {
+			"all": [{"field": "priority", "operator": "is", "value": "urgent"}, {"field": "routing_attribute_value_ids", "operator": "includes_any", "value": ["b376b35a"]}],
+			"any": []
+		}
+	}}`)
+
+	m.EXPECT().Post(gomock.Any(), gomock.Eq("/queues.json"), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, path string, data interface{}) ([]byte, error) {
+			queue := data.(struct {
+				Queue omnichannelQueue `json:"queue"`
+			}).Queue
+			if !reflect.DeepEqual(queue.PrimaryGroupIDs, []int64{3, 8}) || queue.SecondaryGroupIDs == nil || len(queue.SecondaryGroupIDs) != 0 {
+				t.Fatalf("created queue had groups %v and %v", queue.PrimaryGroupIDs, queue.SecondaryGroupIDs)
+			}
+			if len(queue.Definition.All) != 2 || queue.Definition.Any == nil {
+				t.Fatalf("created queue had definition %v", queue.Definition)
+			}
+			for _, c := range queue.Definition.All {
+				if c.Field == "routing_attribute_value_ids" && !reflect.DeepEqual(c.Value, []interface{}{"b376b35a"}) {
+					t.Fatalf("created queue had condition value %v. should have been a list", c.Value)
+				}
+			}
+			return out, nil
+		})
+	if diags := createOmnichannelQueue(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createOmnichannelQueue returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "01HG80ATNNZK1N7XRFVKX48XD6" {
+		t.Fatalf("createOmnichannelQueue did not set resource id. Id was %s", v)
+	}
+
+	if v := i.Get("order"); v != int64(3) {
+		t.Fatalf("createOmnichannelQueue set order %v. should have been 3", v)
+	}
+
+	if v := i.Get("primary_group_ids"); !reflect.DeepEqual(v, []int64{3, 8}) {
+		t.Fatalf("createOmnichannelQueue set primary_group_ids %v", v)
+	}
+
+	alls := i.Get("all").([]map[string]interface{})
+	if len(alls) != 2 || alls[1]["value"] != `["b376b35a"]` {
+		t.Fatalf("createOmnichannelQueue set all %v", alls)
+	}
+}
+
+func TestReadOmnichannelQueueNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("01HG80ATNNZK1N7XRFVKX48XD6")
+
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/queues/01HG80ATNNZK1N7XRFVKX48XD6.json")).Return(nil, notFoundError())
+	if diags := readOmnichannelQueue(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readOmnichannelQueue returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "" {
+		t.Fatalf("readOmnichannelQueue did not remove the deleted queue. Id was %s", v)
+	}
+}
+
+func TestUpdateOmnichannelQueueOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "01HG80ATNNZK1N7XRFVKX48XD6",
+		mapGetterSetter: mapGetterSetter{
+			"name":              "Urgent",
+			"description":       "",
+			"priority":          1,
+			"order":             1,
+			"primary_group_ids": schema.NewSet(schema.HashInt, []interface{}{3}),
+		},
+	}
+	out := []byte(`{"queue": {"id": "01HG80ATNNZK1N7XRFVKX48XD6", "name": "Urgent", "priority": 1, "order": 1, "primary_groups": {"groups": [{"id": 3}]}, "definition": {"all": [], "any": []}}}`)
+
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/queues/01HG80ATNNZK1N7XRFVKX48XD6.json"), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, path string, data interface{}) ([]byte, error) {
+			if method := ctx.Value(methodKey{}); method != http.MethodPatch {
+				t.Fatalf("queue was updated with %v. should have been PATCH", method)
+			}
+			queue := data.(struct {
+				Queue omnichannelQueue `json:"queue"`
+			}).Queue
+			if queue.Order != 1 {
+				t.Fatalf("updated queue had order %d. should have been 1", queue.Order)
+			}
+			return out, nil
+		})
+	if diags := updateOmnichannelQueue(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateOmnichannelQueue returned an error: %v", diags)
+	}
+}
+
+func TestDeleteOmnichannelQueue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("01HG80ATNNZK1N7XRFVKX48XD6")
+
+	m.EXPECT().Delete(gomock.Any(), gomock.Eq("/queues/01HG80ATNNZK1N7XRFVKX48XD6.json")).Return(nil)
+	if diags := deleteOmnichannelQueue(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteOmnichannelQueue returned an error: %v", diags)
+	}
+}
+
+func TestAccOmnichannelQueueExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testHelpCenterDestroyed("zendesk_omnichannel_queue", "/queues/%s.json"),
+		Steps: []resource.TestStep{
+			{
+				Config: concatExampleConfig(t,
+					readExampleConfig(t, "resources/zendesk_group/resource.tf"),
+					readExampleConfig(t, "resources/zendesk_omnichannel_queue/resource.tf"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zendesk_omnichannel_queue.urgent", "order", "1"),
+					resource.TestCheckResourceAttr("zendesk_omnichannel_queue.urgent", "secondary_group_ids.#", "1"),
+					resource.TestCheckResourceAttr("zendesk_omnichannel_queue.japanese", "any.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "zendesk_omnichannel_queue.urgent",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
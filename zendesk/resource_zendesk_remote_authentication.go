@@ -0,0 +1,334 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// remoteAuthentication is the remote authentication (SSO) JSON payload format
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/remote_authentications/#json-format
+type remoteAuthentication struct {
+	ID              int64    `json:"id,omitempty"`
+	URL             string   `json:"url,omitempty"`
+	Type            string   `json:"type,omitempty"`
+	Name            string   `json:"name"`
+	RemoteLoginURL  string   `json:"remote_login_url"`
+	RemoteLogoutURL string   `json:"remote_logout_url"`
+	SAMLMetadataURL string   `json:"saml_metadata_url,omitempty"`
+	Fingerprint     string   `json:"fingerprint,omitempty"`
+	SharedSecret    string   `json:"shared_secret,omitempty"`
+	IPRanges        []string `json:"ip_ranges"`
+	IsActive        bool     `json:"is_active"`
+}
+
+// The attributes which only apply to one type of remote authentication
+var (
+	remoteAuthenticationSAMLAttributes = []string{"saml_metadata_url", "fingerprint"}
+	remoteAuthenticationJWTAttributes  = []string{"shared_secret"}
+)
+
+// https://developer.zendesk.com/api-reference/ticketing/account-configuration/remote_authentications/
+func resourceZendeskRemoteAuthentication() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a remote authentication (SSO) resource, signing users in with SAML or JWT.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return createRemoteAuthentication(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return readRemoteAuthentication(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return updateRemoteAuthentication(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return deleteRemoteAuthentication(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, i interface{}) error {
+			keys := append([]string{"type"}, remoteAuthenticationSAMLAttributes...)
+			for _, k := range append(keys, remoteAuthenticationJWTAttributes...) {
+				if !d.NewValueKnown(k) {
+					return nil
+				}
+			}
+			return validateRemoteAuthentication(d)
+		},
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Description:  "The type of remote authentication: `saml` or `jwt`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"saml", "jwt"}, false),
+			},
+			"name": {
+				Description: "The name of the remote authentication, shown to users choosing how to sign in.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"remote_login_url": {
+				Description:  "The url users are redirected to for signing in.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+			"remote_logout_url": {
+				Description:  "The url users are redirected to after signing out.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+			"saml_metadata_url": {
+				Description:  "The url of the SAML metadata of the identity provider. SAML only, exclusive with `fingerprint`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+			"fingerprint": {
+				Description:  "The SHA1 or SHA256 fingerprint of the certificate of the identity provider, as colon separated hex bytes. SAML only, exclusive with `saml_metadata_url`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: isValidCertificateFingerprint(),
+			},
+			"shared_secret": {
+				Description:  "The secret shared with the identity provider to sign the tokens. JWT only. It can't be read back, so changes made outside of Terraform are not detected.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(32, 1024),
+			},
+			"ip_ranges": {
+				Description: "IP addresses and CIDR ranges whose requests are redirected to the remote authentication. All requests are redirected when empty.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: isValidIPRange(),
+				},
+				Optional: true,
+			},
+			"is_active": {
+				Description: "Whether users can sign in with the remote authentication.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"url": {
+				Description: "The API url of the remote authentication.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// validateRemoteAuthentication checks the attributes specific to SAML and JWT are set for their type only
+func validateRemoteAuthentication(d getter) error {
+	authType := d.Get("type").(string)
+
+	forbidden := remoteAuthenticationJWTAttributes
+	if authType == "jwt" {
+		forbidden = remoteAuthenticationSAMLAttributes
+	}
+	for _, k := range forbidden {
+		if _, ok := d.GetOk(k); ok {
+			return fmt.Errorf("%s can't be set on %q remote authentications", k, authType)
+		}
+	}
+
+	switch authType {
+	case "saml":
+		_, hasMetadata := d.GetOk("saml_metadata_url")
+		_, hasFingerprint := d.GetOk("fingerprint")
+		if hasMetadata == hasFingerprint {
+			return fmt.Errorf(`"saml" remote authentications need exactly one of saml_metadata_url or fingerprint`)
+		}
+	case "jwt":
+		if _, ok := d.GetOk("shared_secret"); !ok {
+			return fmt.Errorf(`"jwt" remote authentications need a shared_secret`)
+		}
+	}
+
+	return nil
+}
+
+func marshalRemoteAuthentication(auth remoteAuthentication, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"type":              auth.Type,
+		"name":              auth.Name,
+		"remote_login_url":  auth.RemoteLoginURL,
+		"remote_logout_url": auth.RemoteLogoutURL,
+		"saml_metadata_url": auth.SAMLMetadataURL,
+		"fingerprint":       auth.Fingerprint,
+		"ip_ranges":         auth.IPRanges,
+		"is_active":         auth.IsActive,
+		"url":               auth.URL,
+	}
+
+	// shared_secret is write only
+	return setSchemaFields(d, fields)
+}
+
+func unmarshalRemoteAuthentication(d identifiableGetterSetter) (remoteAuthentication, error) {
+	auth := remoteAuthentication{
+		IPRanges: []string{},
+	}
+
+	if v := d.Id(); v != "" {
+		id, err := atoi64(v)
+		if err != nil {
+			return auth, fmt.Errorf("could not parse remote authentication id %s: %v", v, err)
+		}
+		auth.ID = id
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		auth.Type = v.(string)
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		auth.Name = v.(string)
+	}
+
+	if v, ok := d.GetOk("remote_login_url"); ok {
+		auth.RemoteLoginURL = v.(string)
+	}
+
+	if v, ok := d.GetOk("remote_logout_url"); ok {
+		auth.RemoteLogoutURL = v.(string)
+	}
+
+	if v, ok := d.GetOk("saml_metadata_url"); ok {
+		auth.SAMLMetadataURL = v.(string)
+	}
+
+	if v, ok := d.GetOk("fingerprint"); ok {
+		auth.Fingerprint = v.(string)
+	}
+
+	if v, ok := d.GetOk("shared_secret"); ok {
+		auth.SharedSecret = v.(string)
+	}
+
+	if v, ok := d.GetOk("ip_ranges"); ok {
+		for _, r := range v.(*schema.Set).List() {
+			auth.IPRanges = append(auth.IPRanges, r.(string))
+		}
+	}
+
+	if v, ok := d.GetOk("is_active"); ok {
+		auth.IsActive = v.(bool)
+	}
+
+	return auth, nil
+}
+
+func createRemoteAuthentication(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	auth, err := unmarshalRemoteAuthentication(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var data, result struct {
+		RemoteAuthentication remoteAuthentication `json:"remote_authentication"`
+	}
+	data.RemoteAuthentication = auth
+
+	err = postJSON(ctx, zd, "/remote_authentications.json", data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", result.RemoteAuthentication.ID))
+
+	err = marshalRemoteAuthentication(result.RemoteAuthentication, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readRemoteAuthentication(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result struct {
+		RemoteAuthentication remoteAuthentication `json:"remote_authentication"`
+	}
+	err = getJSON(ctx, zd, fmt.Sprintf("/remote_authentications/%d.json", id), &result)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalRemoteAuthentication(result.RemoteAuthentication, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateRemoteAuthentication(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	auth, err := unmarshalRemoteAuthentication(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var data, result struct {
+		RemoteAuthentication remoteAuthentication `json:"remote_authentication"`
+	}
+	data.RemoteAuthentication = auth
+
+	err = putJSON(ctx, zd, fmt.Sprintf("/remote_authentications/%d.json", auth.ID), data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalRemoteAuthentication(result.RemoteAuthentication, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func deleteRemoteAuthentication(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = zd.Delete(ctx, fmt.Sprintf("/remote_authentications/%d.json", id))
+	if err != nil && !isNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,238 @@
+package zendesk
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestCreateRemoteAuthentication(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"type":             "jwt",
+			"name":             "Resistance JWT",
+			"remote_login_url": "https://sso.resistance.example.com/jwt/login",
+			"shared_secret":    "0123456789abcdef0123456789abcdef",
+			"ip_ranges":        schema.NewSet(schema.HashString, []interface{}{"192.0.2.0/24"}),
+		},
+	}
+	out := []byte(`{"remote_authentication": {"id": 1234, "type": "jwt", "name": "Resistance JWT", "remote_login_url": "https://sso.resistance.example.com/jwt/login", "ip_ranges": ["192.0.2.0/24"], "is_active": false}}`)
+
+	m.EXPECT().Post(gomock.Any(), gomock.Eq("/remote_authentications.json"), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, path string, data interface{}) ([]byte, error) {
+			auth := data.(struct {
+				RemoteAuthentication remoteAuthentication `json:"remote_authentication"`
+			}).RemoteAuthentication
+			if auth.SharedSecret != "0123456789abcdef0123456789abcdef" || len(auth.IPRanges) != 1 {
+				t.Fatalf("created remote authentication was %v", auth)
+			}
+			return out, nil
+		})
+	if diags := createRemoteAuthentication(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createRemoteAuthentication returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "1234" {
+		t.Fatalf("createRemoteAuthentication did not set resource id. Id was %s", v)
+	}
+
+	// the shared secret is not returned and should be kept
+	if v := i.Get("shared_secret"); v != "0123456789abcdef0123456789abcdef" {
+		t.Fatalf("createRemoteAuthentication changed shared_secret to %v", v)
+	}
+}
+
+func TestReadRemoteAuthentication(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("1234")
+
+	out := []byte(`{"remote_authentication": {"id": 1234, "type": "saml", "name": "Skynet SAML", "remote_login_url": "https://sso.skynet.example.com/saml/login", "fingerprint": "9A:2B:3C:4D:5E:6F:70:81:92:A3:B4:C5:D6:E7:F8:09:1A:2B:3C:4D", "ip_ranges": [], "is_active": true}}`)
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/remote_authentications/1234.json")).Return(out, nil)
+	if diags := readRemoteAuthentication(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readRemoteAuthentication returned an error: %v", diags)
+	}
+
+	if v := i.Get("fingerprint"); v != "9A:2B:3C:4D:5E:6F:70:81:92:A3:B4:C5:D6:E7:F8:09:1A:2B:3C:4D" {
+		t.Fatalf("readRemoteAuthentication set fingerprint %v", v)
+	}
+
+	if v := i.Get("is_active"); v != true {
+		t.Fatalf("readRemoteAuthentication set is_active %v. should have been true", v)
+	}
+}
+
+func TestReadRemoteAuthenticationNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("1234")
+
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/remote_authentications/1234.json")).Return(nil, notFoundError())
+	if diags := readRemoteAuthentication(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readRemoteAuthentication returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "" {
+		t.Fatalf("readRemoteAuthentication did not remove the deleted remote authentication. Id was %s", v)
+	}
+}
+
+func TestUpdateRemoteAuthentication(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "1234",
+		mapGetterSetter: mapGetterSetter{
+			"type":              "saml",
+			"name":              "Skynet SAML",
+			"remote_login_url":  "https://sso.skynet.example.com/saml/login",
+			"saml_metadata_url": "https://sso.skynet.example.com/saml/metadata",
+			"is_active":         true,
+		},
+	}
+	out := []byte(`{"remote_authentication": {"id": 1234, "type": "saml", "name": "Skynet SAML", "remote_login_url": "https://sso.skynet.example.com/saml/login", "saml_metadata_url": "https://sso.skynet.example.com/saml/metadata", "ip_ranges": [], "is_active": true}}`)
+
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/remote_authentications/1234.json"), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, path string, data interface{}) ([]byte, error) {
+			auth := data.(struct {
+				RemoteAuthentication remoteAuthentication `json:"remote_authentication"`
+			}).RemoteAuthentication
+			if auth.IPRanges == nil || len(auth.IPRanges) != 0 {
+				t.Fatalf("updated remote authentication had ip_ranges %v. should have been empty", auth.IPRanges)
+			}
+			return out, nil
+		})
+	if diags := updateRemoteAuthentication(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateRemoteAuthentication returned an error: %v", diags)
+	}
+}
+
+func TestDeleteRemoteAuthentication(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("1234")
+
+	m.EXPECT().Delete(gomock.Any(), gomock.Eq("/remote_authentications/1234.json")).Return(nil)
+	if diags := deleteRemoteAuthentication(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteRemoteAuthentication returned an error: %v", diags)
+	}
+}
+
+func TestValidateRemoteAuthentication(t *testing.T) {
+	cases := []struct {
+		name   string
+		config mapGetterSetter
+		err    string
+	}{
+		{
+			name:   "saml with metadata url",
+			config: mapGetterSetter{"type": "saml", "saml_metadata_url": "https://sso.example.com/metadata"},
+		},
+		{
+			name:   "saml with fingerprint",
+			config: mapGetterSetter{"type": "saml", "fingerprint": "9A:2B:3C:4D:5E:6F:70:81:92:A3:B4:C5:D6:E7:F8:09:1A:2B:3C:4D"},
+		},
+		{
+			name:   "jwt with shared secret",
+			config: mapGetterSetter{"type": "jwt", "shared_secret": "0123456789abcdef0123456789abcdef"},
+		},
+		{
+			name:   "saml without metadata url nor fingerprint",
+			config: mapGetterSetter{"type": "saml"},
+			err:    "exactly one of",
+		},
+		{
+			name: "saml with metadata url and fingerprint",
+			config: mapGetterSetter{
+				"type":              "saml",
+				"saml_metadata_url": "https://sso.example.com/metadata",
+				"fingerprint":       "9A:2B:3C:4D:5E:6F:70:81:92:A3:B4:C5:D6:E7:F8:09:1A:2B:3C:4D",
+			},
+			err: "exactly one of",
+		},
+		{
+			name: "saml with shared secret",
+			config: mapGetterSetter{
+				"type":              "saml",
+				"saml_metadata_url": "https://sso.example.com/metadata",
+				"shared_secret":     "0123456789abcdef0123456789abcdef",
+			},
+			err: "shared_secret can't be set",
+		},
+		{
+			name:   "jwt without shared secret",
+			config: mapGetterSetter{"type": "jwt"},
+			err:    "need a shared_secret",
+		},
+		{
+			name: "jwt with fingerprint",
+			config: mapGetterSetter{
+				"type":          "jwt",
+				"shared_secret": "0123456789abcdef0123456789abcdef",
+				"fingerprint":   "9A:2B:3C:4D:5E:6F:70:81:92:A3:B4:C5:D6:E7:F8:09:1A:2B:3C:4D",
+			},
+			err: "fingerprint can't be set",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := validateRemoteAuthentication(c.config)
+			if c.err == "" {
+				if err != nil {
+					t.Fatalf("validateRemoteAuthentication returned an error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Fatalf("validateRemoteAuthentication returned %v. should have contained %q", err, c.err)
+			}
+		})
+	}
+}
+
+func TestAccRemoteAuthenticationExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testHelpCenterDestroyed("zendesk_remote_authentication", "/remote_authentications/%s.json"),
+		Steps: []resource.TestStep{
+			{
+				Config: readExampleConfig(t, "resources/zendesk_remote_authentication/resource.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zendesk_remote_authentication.skynet-saml", "type", "saml"),
+					resource.TestCheckResourceAttr("zendesk_remote_authentication.skynet-saml", "ip_ranges.#", "1"),
+					resource.TestCheckResourceAttr("zendesk_remote_authentication.resistance-jwt", "is_active", "false"),
+				),
+			},
+			{
+				ResourceName:            "zendesk_remote_authentication.resistance-jwt",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"shared_secret"},
+			},
+		},
+	})
+}
@@ -0,0 +1,145 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// routingAttribute is the skills-based routing attribute JSON payload format
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#json-format
+type routingAttribute struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+// https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/
+func resourceZendeskRoutingAttribute() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a skills-based routing attribute, a category of skills such as languages or products.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return createRoutingAttribute(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return readRoutingAttribute(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return updateRoutingAttribute(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return deleteRoutingAttribute(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the attribute.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+		},
+	}
+}
+
+func marshalRoutingAttribute(attribute routingAttribute, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"name": attribute.Name,
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+func unmarshalRoutingAttribute(d identifiableGetterSetter) routingAttribute {
+	return routingAttribute{
+		ID:   d.Id(),
+		Name: d.Get("name").(string),
+	}
+}
+
+func createRoutingAttribute(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var data, result struct {
+		Attribute routingAttribute `json:"attribute"`
+	}
+	data.Attribute = unmarshalRoutingAttribute(d)
+
+	err := postJSON(ctx, zd, "/routing/attributes.json", data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(result.Attribute.ID)
+
+	err = marshalRoutingAttribute(result.Attribute, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readRoutingAttribute(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var result struct {
+		Attribute routingAttribute `json:"attribute"`
+	}
+	err := getJSON(ctx, zd, fmt.Sprintf("/routing/attributes/%s.json", d.Id()), &result)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalRoutingAttribute(result.Attribute, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateRoutingAttribute(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var data, result struct {
+		Attribute routingAttribute `json:"attribute"`
+	}
+	data.Attribute = unmarshalRoutingAttribute(d)
+
+	err := putJSON(ctx, zd, fmt.Sprintf("/routing/attributes/%s.json", d.Id()), data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalRoutingAttribute(result.Attribute, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func deleteRoutingAttribute(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := zd.Delete(ctx, fmt.Sprintf("/routing/attributes/%s.json", d.Id()))
+	if err != nil && !isNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,125 @@
+package zendesk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestCreateRoutingAttribute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"name": "Language",
+		},
+	}
+	out := []byte(`{"attribute": {"id": "15821cba-7326-11e8-b07e-950ba849aa27", "name": "Language"}}`)
+
+	m.EXPECT().Post(gomock.Any(), gomock.Eq("/routing/attributes.json"), gomock.Any()).Return(out, nil)
+	if diags := createRoutingAttribute(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createRoutingAttribute returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "15821cba-7326-11e8-b07e-950ba849aa27" {
+		t.Fatalf("createRoutingAttribute did not set resource id. Id was %s", v)
+	}
+}
+
+func TestReadRoutingAttribute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("15821cba-7326-11e8-b07e-950ba849aa27")
+
+	out := []byte(`{"attribute": {"id": "15821cba-7326-11e8-b07e-950ba849aa27", "name": "Languages"}}`)
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/routing/attributes/15821cba-7326-11e8-b07e-950ba849aa27.json")).Return(out, nil)
+	if diags := readRoutingAttribute(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readRoutingAttribute returned an error: %v", diags)
+	}
+
+	if v := i.Get("name"); v != "Languages" {
+		t.Fatalf("readRoutingAttribute set name %v. should have been Languages", v)
+	}
+}
+
+func TestReadRoutingAttributeNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("15821cba-7326-11e8-b07e-950ba849aa27")
+
+	m.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, notFoundError())
+	if diags := readRoutingAttribute(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readRoutingAttribute returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "" {
+		t.Fatalf("readRoutingAttribute did not remove the deleted attribute. Id was %s", v)
+	}
+}
+
+func TestUpdateRoutingAttribute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "15821cba-7326-11e8-b07e-950ba849aa27",
+		mapGetterSetter: mapGetterSetter{
+			"name": "Languages",
+		},
+	}
+	out := []byte(`{"attribute": {"id": "15821cba-7326-11e8-b07e-950ba849aa27", "name": "Languages"}}`)
+
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/routing/attributes/15821cba-7326-11e8-b07e-950ba849aa27.json"), gomock.Any()).Return(out, nil)
+	if diags := updateRoutingAttribute(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateRoutingAttribute returned an error: %v", diags)
+	}
+}
+
+func TestDeleteRoutingAttribute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("15821cba-7326-11e8-b07e-950ba849aa27")
+
+	m.EXPECT().Delete(gomock.Any(), gomock.Eq("/routing/attributes/15821cba-7326-11e8-b07e-950ba849aa27.json")).Return(nil)
+	if diags := deleteRoutingAttribute(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteRoutingAttribute returned an error: %v", diags)
+	}
+}
+
+func TestAccRoutingAttributeExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testHelpCenterDestroyed("zendesk_routing_attribute", "/routing/attributes/%s.json"),
+		Steps: []resource.TestStep{
+			{
+				Config: readExampleConfig(t, "resources/zendesk_routing_attribute/resource.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zendesk_routing_attribute.language", "name", "Language"),
+				),
+			},
+			{
+				ResourceName:      "zendesk_routing_attribute.language",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,181 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// routingAttributeValue is the skills-based routing attribute value JSON payload format
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/#json-format
+type routingAttributeValue struct {
+	ID          string `json:"id,omitempty"`
+	AttributeID string `json:"attribute_id,omitempty"`
+	Name        string `json:"name"`
+}
+
+// https://developer.zendesk.com/api-reference/ticketing/ticket-management/skill_based_routing/
+func resourceZendeskRoutingAttributeValue() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a value of a skills-based routing attribute, the skill assigned to agents and required by tickets. " +
+			"Import with the id `<attribute_id>/<value_id>`.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return createRoutingAttributeValue(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return readRoutingAttributeValue(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return updateRoutingAttributeValue(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return deleteRoutingAttributeValue(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+				attributeID, valueID, err := parseRoutingAttributeValueID(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				err = setSchemaFields(d, map[string]interface{}{
+					"attribute_id": attributeID,
+					"value_id":     valueID,
+				})
+				return []*schema.ResourceData{d}, err
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"attribute_id": {
+				Description: "The id of the attribute the value belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the value.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"value_id": {
+				Description: "The id of the value, referenced by `zendesk_agent_skills` and by the skill actions of triggers.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func parseRoutingAttributeValueID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("could not parse routing attribute value id %s: should be <attribute_id>/<value_id>", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func routingAttributeValuePath(d getter) string {
+	return fmt.Sprintf("/routing/attributes/%s/values/%s.json", d.Get("attribute_id").(string), d.Get("value_id").(string))
+}
+
+func marshalRoutingAttributeValue(value routingAttributeValue, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"name":     value.Name,
+		"value_id": value.ID,
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+func createRoutingAttributeValue(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	attributeID := d.Get("attribute_id").(string)
+
+	var data, result struct {
+		AttributeValue routingAttributeValue `json:"attribute_value"`
+	}
+	data.AttributeValue = routingAttributeValue{
+		Name: d.Get("name").(string),
+	}
+
+	err := postJSON(ctx, zd, fmt.Sprintf("/routing/attributes/%s/values.json", attributeID), data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", attributeID, result.AttributeValue.ID))
+
+	err = marshalRoutingAttributeValue(result.AttributeValue, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func readRoutingAttributeValue(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var result struct {
+		AttributeValue routingAttributeValue `json:"attribute_value"`
+	}
+	err := getJSON(ctx, zd, routingAttributeValuePath(d), &result)
+	if isNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalRoutingAttributeValue(result.AttributeValue, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateRoutingAttributeValue(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var data, result struct {
+		AttributeValue routingAttributeValue `json:"attribute_value"`
+	}
+	data.AttributeValue = routingAttributeValue{
+		Name: d.Get("name").(string),
+	}
+
+	err := putJSON(ctx, zd, routingAttributeValuePath(d), data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalRoutingAttributeValue(result.AttributeValue, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func deleteRoutingAttributeValue(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := zd.Delete(ctx, routingAttributeValuePath(d))
+	if err != nil && !isNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,140 @@
+package zendesk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestCreateRoutingAttributeValue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"attribute_id": "15821cba-7326-11e8-b07e-950ba849aa27",
+			"name":         "Japanese",
+		},
+	}
+	out := []byte(`{"attribute_value": {"id": "b376b35a-e38b-11e8-a292-e3b6377c5575", "attribute_id": "15821cba-7326-11e8-b07e-950ba849aa27", "name": "Japanese"}}`)
+
+	m.EXPECT().Post(gomock.Any(), gomock.Eq("/routing/attributes/15821cba-7326-11e8-b07e-950ba849aa27/values.json"), gomock.Any()).Return(out, nil)
+	if diags := createRoutingAttributeValue(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createRoutingAttributeValue returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "15821cba-7326-11e8-b07e-950ba849aa27/b376b35a-e38b-11e8-a292-e3b6377c5575" {
+		t.Fatalf("createRoutingAttributeValue did not set resource id. Id was %s", v)
+	}
+
+	if v := i.Get("value_id"); v != "b376b35a-e38b-11e8-a292-e3b6377c5575" {
+		t.Fatalf("createRoutingAttributeValue set value_id %v", v)
+	}
+}
+
+func TestReadRoutingAttributeValueNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "15821cba-7326-11e8-b07e-950ba849aa27/b376b35a-e38b-11e8-a292-e3b6377c5575",
+		mapGetterSetter: mapGetterSetter{
+			"attribute_id": "15821cba-7326-11e8-b07e-950ba849aa27",
+			"value_id":     "b376b35a-e38b-11e8-a292-e3b6377c5575",
+		},
+	}
+
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/routing/attributes/15821cba-7326-11e8-b07e-950ba849aa27/values/b376b35a-e38b-11e8-a292-e3b6377c5575.json")).Return(nil, notFoundError())
+	if diags := readRoutingAttributeValue(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readRoutingAttributeValue returned an error: %v", diags)
+	}
+
+	if v := i.Id(); v != "" {
+		t.Fatalf("readRoutingAttributeValue did not remove the deleted value. Id was %s", v)
+	}
+}
+
+func TestUpdateRoutingAttributeValue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "15821cba-7326-11e8-b07e-950ba849aa27/b376b35a-e38b-11e8-a292-e3b6377c5575",
+		mapGetterSetter: mapGetterSetter{
+			"attribute_id": "15821cba-7326-11e8-b07e-950ba849aa27",
+			"value_id":     "b376b35a-e38b-11e8-a292-e3b6377c5575",
+			"name":         "日本語",
+		},
+	}
+	out := []byte(`{"attribute_value": {"id": "b376b35a-e38b-11e8-a292-e3b6377c5575", "name": "日本語"}}`)
+
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/routing/attributes/15821cba-7326-11e8-b07e-950ba849aa27/values/b376b35a-e38b-11e8-a292-e3b6377c5575.json"), gomock.Any()).Return(out, nil)
+	if diags := updateRoutingAttributeValue(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateRoutingAttributeValue returned an error: %v", diags)
+	}
+}
+
+func TestDeleteRoutingAttributeValue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "15821cba-7326-11e8-b07e-950ba849aa27/b376b35a-e38b-11e8-a292-e3b6377c5575",
+		mapGetterSetter: mapGetterSetter{
+			"attribute_id": "15821cba-7326-11e8-b07e-950ba849aa27",
+			"value_id":     "b376b35a-e38b-11e8-a292-e3b6377c5575",
+		},
+	}
+
+	m.EXPECT().Delete(gomock.Any(), gomock.Eq("/routing/attributes/15821cba-7326-11e8-b07e-950ba849aa27/values/b376b35a-e38b-11e8-a292-e3b6377c5575.json")).Return(nil)
+	if diags := deleteRoutingAttributeValue(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("deleteRoutingAttributeValue returned an error: %v", diags)
+	}
+}
+
+func TestParseRoutingAttributeValueID(t *testing.T) {
+	attributeID, valueID, err := parseRoutingAttributeValueID("15821cba/b376b35a")
+	if err != nil || attributeID != "15821cba" || valueID != "b376b35a" {
+		t.Fatalf("parseRoutingAttributeValueID returned %s, %s, %v", attributeID, valueID, err)
+	}
+
+	for _, id := range []string{"b376b35a", "15821cba/", "/b376b35a", "a/b/c"} {
+		if _, _, err := parseRoutingAttributeValueID(id); err == nil {
+			t.Fatalf("parseRoutingAttributeValueID did not return an error for %s", id)
+		}
+	}
+}
+
+func TestAccRoutingAttributeValueExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: concatExampleConfig(t,
+					readExampleConfig(t, "resources/zendesk_routing_attribute/resource.tf"),
+					readExampleConfig(t, "resources/zendesk_routing_attribute_value/resource.tf"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zendesk_routing_attribute_value.japanese", "name", "Japanese"),
+					resource.TestCheckResourceAttrSet("zendesk_routing_attribute_value.japanese", "value_id"),
+					resource.TestCheckResourceAttrPair("zendesk_routing_attribute_value.japanese", "attribute_id", "zendesk_routing_attribute.language", "id"),
+				),
+			},
+			{
+				ResourceName:      "zendesk_routing_attribute_value.japanese",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -10,29 +10,79 @@ import (
 	client "github.com/nukosuke/go-zendesk/zendesk"
 )
 
+// slaPolicy is the SLA policy payload including fields not yet supported by the client
+type slaPolicy struct {
+	client.SLAPolicy
+	MetricSettings *slaPolicyMetricSettings `json:"metric_settings,omitempty"`
+}
+
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/sla_policies/#metric-settings
+type slaPolicyMetricSettings struct {
+	FirstReplyTime     *slaPolicyFirstReplyTimeSettings     `json:"first_reply_time,omitempty"`
+	NextReplyTime      *slaPolicyNextReplyTimeSettings      `json:"next_reply_time,omitempty"`
+	PeriodicUpdateTime *slaPolicyPeriodicUpdateTimeSettings `json:"periodic_update_time,omitempty"`
+}
+
+type slaPolicyFirstReplyTimeSettings struct {
+	ActivateOnTicketCreatedForEndUser                      bool `json:"activate_on_ticket_created_for_end_user"`
+	ActivateOnAgentTicketCreatedForEndUserWithInternalNote bool `json:"activate_on_agent_ticket_created_for_end_user_with_internal_note"`
+	ActivateOnLightAgentOnEmailForwardTicketFromEndUser    bool `json:"activate_on_light_agent_on_email_forward_ticket_from_end_user"`
+	ActivateOnAgentCreatedTicketForSelf                    bool `json:"activate_on_agent_created_ticket_for_self"`
+	FulfillOnAgentInternalNote                             bool `json:"fulfill_on_agent_internal_note"`
+}
+
+type slaPolicyNextReplyTimeSettings struct {
+	FulfillOnNonRequestingAgentInternalNoteAfterActivation bool `json:"fulfill_on_non_requesting_agent_internal_note_after_activation"`
+	ActivateOnEndUserAddedInternalNote                     bool `json:"activate_on_end_user_added_internal_note"`
+	ActivateOnAgentRequestedTicketCreatedForEndUser        bool `json:"activate_on_agent_requested_ticket_created_for_end_user"`
+	ActivateOnLightAgentOnEmailForwardTicketFromEndUser    bool `json:"activate_on_light_agent_on_email_forward_ticket_from_end_user"`
+	ActivateOnAgentCreatedTicketForSelf                    bool `json:"activate_on_agent_created_ticket_for_self"`
+	FulfillOnAgentInternalNote                             bool `json:"fulfill_on_agent_internal_note"`
+}
+
+type slaPolicyPeriodicUpdateTimeSettings struct {
+	ActivateOnAgentInternalNote bool `json:"activate_on_agent_internal_note"`
+}
+
+// Priorities a policy metric can target
+var slaPolicyPriorities = []string{
+	"low",
+	"normal",
+	"high",
+	"urgent",
+}
+
 // https://developer.zendesk.com/api-reference/ticketing/business-rules/sla_policies/
 func resourceZendeskSLAPolicy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Provides a SLA policy resource.",
 		CreateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
-			zd := i.(client.SLAPolicyAPI)
+			zd := i.(client.BaseAPI)
 			return createSLAPolicy(ctx, d, zd)
 		},
 		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
-			zd := i.(client.SLAPolicyAPI)
+			zd := i.(client.BaseAPI)
 			return readSLAPolicy(ctx, d, zd)
 		},
 		UpdateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
-			zd := i.(client.SLAPolicyAPI)
+			zd := i.(client.BaseAPI)
 			return updateSLAPolicy(ctx, d, zd)
 		},
 		DeleteContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
-			zd := i.(client.SLAPolicyAPI)
+			zd := i.(client.BaseAPI)
 			return deleteSLAPolicy(ctx, d, zd)
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceZendeskSLAPolicyV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceZendeskSLAPolicyStateUpgradeV0,
+				Version: 0,
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"title": {
@@ -40,12 +90,6 @@ func resourceZendeskSLAPolicy() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 			},
-			// ???: seems this field is not in API document. Should be removed?
-			"active": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
-			},
 			"position": {
 				Description: "Position of the SLA policy that determines the order they will be matched. If not specified, the SLA policy is added as the last position.",
 				Type:        schema.TypeInt,
@@ -61,9 +105,10 @@ func resourceZendeskSLAPolicy() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"priority": {
-							Description: "Priority that a ticket must match.",
-							Type:        schema.TypeString,
-							Required:    true,
+							Description:  `Priority that a ticket must match. Allowed values are "low", "normal", "high", or "urgent".`,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(slaPolicyPriorities, false),
 						},
 						"metric": {
 							Description: "The definition of the time that is being measured.",
@@ -99,15 +144,189 @@ func resourceZendeskSLAPolicy() *schema.Resource {
 				Optional:    true,
 				Default:     "",
 			},
+			"metric_settings": {
+				Description: "Settings that change when metrics are activated or fulfilled.",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"first_reply_time": {
+							Description: "Settings for the first reply time metric.",
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"activate_on_ticket_created_for_end_user": {
+										Description: "Activate the metric when an agent creates a ticket on behalf of an end user.",
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Computed:    true,
+									},
+									"activate_on_agent_ticket_created_for_end_user_with_internal_note": {
+										Description: "Activate the metric when an agent creates a ticket on behalf of an end user with an internal note.",
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Computed:    true,
+									},
+									"activate_on_light_agent_on_email_forward_ticket_from_end_user": {
+										Description: "Activate the metric when a light agent forwards an email from an end user.",
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Computed:    true,
+									},
+									"activate_on_agent_created_ticket_for_self": {
+										Description: "Activate the metric when an agent creates a ticket for themselves.",
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Computed:    true,
+									},
+									"fulfill_on_agent_internal_note": {
+										Description: "Fulfill the metric when an agent adds an internal note.",
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"next_reply_time": {
+							Description: "Settings for the next reply time metric.",
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fulfill_on_non_requesting_agent_internal_note_after_activation": {
+										Description: "Fulfill the metric when an agent other than the requester adds an internal note after the metric was activated.",
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Computed:    true,
+									},
+									"activate_on_end_user_added_internal_note": {
+										Description: "Activate the metric when an end user adds an internal note.",
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Computed:    true,
+									},
+									"activate_on_agent_requested_ticket_created_for_end_user": {
+										Description: "Activate the metric when an agent requested ticket is created on behalf of an end user.",
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Computed:    true,
+									},
+									"activate_on_light_agent_on_email_forward_ticket_from_end_user": {
+										Description: "Activate the metric when a light agent forwards an email from an end user.",
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Computed:    true,
+									},
+									"activate_on_agent_created_ticket_for_self": {
+										Description: "Activate the metric when an agent creates a ticket for themselves.",
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Computed:    true,
+									},
+									"fulfill_on_agent_internal_note": {
+										Description: "Fulfill the metric when an agent adds an internal note.",
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"periodic_update_time": {
+							Description: "Settings for the periodic update time metric.",
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"activate_on_agent_internal_note": {
+										Description: "Activate the metric when an agent adds an internal note.",
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceZendeskSLAPolicyV0 is the schema before the "active" attribute was removed
+func resourceZendeskSLAPolicyV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"position": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"all": slaPolicyFilterSchema(""),
+			"any": slaPolicyFilterSchema(""),
+			"policy_metrics": {
+				Type: schema.TypeSet,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"priority": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"metric": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"target": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"business_hours": {
+							Type:     schema.TypeBool,
+							Default:  false,
+							Optional: true,
+						},
+					},
+				},
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
 		},
 	}
 }
 
+// "active" was never part of the SLA policy API, so it is dropped from the state
+func resourceZendeskSLAPolicyStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	delete(rawState, "active")
+	return rawState, nil
+}
+
 // Marshal the zendesk client object to the terraform schema
-func marshalSLAPolicy(slaPolicy client.SLAPolicy, d identifiableGetterSetter) error {
+func marshalSLAPolicy(slaPolicy slaPolicy, d identifiableGetterSetter) error {
 	fields := map[string]interface{}{
 		"title":       slaPolicy.Title,
-		"active":      slaPolicy.Active,
 		"position":    slaPolicy.Position,
 		"description": slaPolicy.Description,
 	}
@@ -146,12 +365,50 @@ func marshalSLAPolicy(slaPolicy client.SLAPolicy, d identifiableGetterSetter) er
 	}
 
 	fields["policy_metrics"] = metrics
+
+	var settings []map[string]interface{}
+	if ms := slaPolicy.MetricSettings; ms != nil {
+		m := map[string]interface{}{}
+		if v := ms.FirstReplyTime; v != nil {
+			m["first_reply_time"] = []map[string]interface{}{
+				{
+					"activate_on_ticket_created_for_end_user":                          v.ActivateOnTicketCreatedForEndUser,
+					"activate_on_agent_ticket_created_for_end_user_with_internal_note": v.ActivateOnAgentTicketCreatedForEndUserWithInternalNote,
+					"activate_on_light_agent_on_email_forward_ticket_from_end_user":    v.ActivateOnLightAgentOnEmailForwardTicketFromEndUser,
+					"activate_on_agent_created_ticket_for_self":                        v.ActivateOnAgentCreatedTicketForSelf,
+					"fulfill_on_agent_internal_note":                                   v.FulfillOnAgentInternalNote,
+				},
+			}
+		}
+		if v := ms.NextReplyTime; v != nil {
+			m["next_reply_time"] = []map[string]interface{}{
+				{
+					"fulfill_on_non_requesting_agent_internal_note_after_activation": v.FulfillOnNonRequestingAgentInternalNoteAfterActivation,
+					"activate_on_end_user_added_internal_note":                       v.ActivateOnEndUserAddedInternalNote,
+					"activate_on_agent_requested_ticket_created_for_end_user":        v.ActivateOnAgentRequestedTicketCreatedForEndUser,
+					"activate_on_light_agent_on_email_forward_ticket_from_end_user":  v.ActivateOnLightAgentOnEmailForwardTicketFromEndUser,
+					"activate_on_agent_created_ticket_for_self":                      v.ActivateOnAgentCreatedTicketForSelf,
+					"fulfill_on_agent_internal_note":                                 v.FulfillOnAgentInternalNote,
+				},
+			}
+		}
+		if v := ms.PeriodicUpdateTime; v != nil {
+			m["periodic_update_time"] = []map[string]interface{}{
+				{
+					"activate_on_agent_internal_note": v.ActivateOnAgentInternalNote,
+				},
+			}
+		}
+		settings = append(settings, m)
+	}
+	fields["metric_settings"] = settings
+
 	return setSchemaFields(d, fields)
 }
 
 // Unmarshal the terraform schema to the Zendesk client object
-func unmarshalSLAPolicy(d identifiableGetterSetter) (client.SLAPolicy, error) {
-	sla := client.SLAPolicy{}
+func unmarshalSLAPolicy(d identifiableGetterSetter) (slaPolicy, error) {
+	sla := slaPolicy{}
 
 	if v := d.Id(); v != "" {
 		id, err := atoi64(v)
@@ -165,10 +422,6 @@ func unmarshalSLAPolicy(d identifiableGetterSetter) (client.SLAPolicy, error) {
 		sla.Title = v.(string)
 	}
 
-	if v, ok := d.GetOk("active"); ok {
-		sla.Active = v.(bool)
-	}
-
 	if v, ok := d.GetOk("description"); ok {
 		sla.Description = v.(string)
 	}
@@ -226,10 +479,53 @@ func unmarshalSLAPolicy(d identifiableGetterSetter) (client.SLAPolicy, error) {
 		sla.PolicyMetrics = metrics
 	}
 
+	if v, ok := d.GetOk("metric_settings"); ok {
+		settings := v.([]interface{})
+		if len(settings) > 0 && settings[0] != nil {
+			sla.MetricSettings = unmarshalSLAPolicyMetricSettings(settings[0].(map[string]interface{}))
+		}
+	}
+
 	return sla, nil
 }
 
-func createSLAPolicy(ctx context.Context, d identifiableGetterSetter, zd client.SLAPolicyAPI) diag.Diagnostics {
+func unmarshalSLAPolicyMetricSettings(settings map[string]interface{}) *slaPolicyMetricSettings {
+	ms := &slaPolicyMetricSettings{}
+
+	if v, ok := settings["first_reply_time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		ms.FirstReplyTime = &slaPolicyFirstReplyTimeSettings{
+			ActivateOnTicketCreatedForEndUser:                      m["activate_on_ticket_created_for_end_user"].(bool),
+			ActivateOnAgentTicketCreatedForEndUserWithInternalNote: m["activate_on_agent_ticket_created_for_end_user_with_internal_note"].(bool),
+			ActivateOnLightAgentOnEmailForwardTicketFromEndUser:    m["activate_on_light_agent_on_email_forward_ticket_from_end_user"].(bool),
+			ActivateOnAgentCreatedTicketForSelf:                    m["activate_on_agent_created_ticket_for_self"].(bool),
+			FulfillOnAgentInternalNote:                             m["fulfill_on_agent_internal_note"].(bool),
+		}
+	}
+
+	if v, ok := settings["next_reply_time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		ms.NextReplyTime = &slaPolicyNextReplyTimeSettings{
+			FulfillOnNonRequestingAgentInternalNoteAfterActivation: m["fulfill_on_non_requesting_agent_internal_note_after_activation"].(bool),
+			ActivateOnEndUserAddedInternalNote:                     m["activate_on_end_user_added_internal_note"].(bool),
+			ActivateOnAgentRequestedTicketCreatedForEndUser:        m["activate_on_agent_requested_ticket_created_for_end_user"].(bool),
+			ActivateOnLightAgentOnEmailForwardTicketFromEndUser:    m["activate_on_light_agent_on_email_forward_ticket_from_end_user"].(bool),
+			ActivateOnAgentCreatedTicketForSelf:                    m["activate_on_agent_created_ticket_for_self"].(bool),
+			FulfillOnAgentInternalNote:                             m["fulfill_on_agent_internal_note"].(bool),
+		}
+	}
+
+	if v, ok := settings["periodic_update_time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		ms.PeriodicUpdateTime = &slaPolicyPeriodicUpdateTimeSettings{
+			ActivateOnAgentInternalNote: m["activate_on_agent_internal_note"].(bool),
+		}
+	}
+
+	return ms
+}
+
+func createSLAPolicy(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	sla, err := unmarshalSLAPolicy(d)
@@ -237,7 +533,7 @@ func createSLAPolicy(ctx context.Context, d identifiableGetterSetter, zd client.
 		return diag.FromErr(err)
 	}
 
-	sla, err = zd.CreateSLAPolicy(ctx, sla)
+	sla, err = postSLAPolicy(ctx, zd, "/slas/policies.json", sla)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -252,7 +548,7 @@ func createSLAPolicy(ctx context.Context, d identifiableGetterSetter, zd client.
 	return diags
 }
 
-func readSLAPolicy(ctx context.Context, d identifiableGetterSetter, zd client.SLAPolicyAPI) diag.Diagnostics {
+func readSLAPolicy(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	id, err := atoi64(d.Id())
@@ -260,12 +556,15 @@ func readSLAPolicy(ctx context.Context, d identifiableGetterSetter, zd client.SL
 		return diag.FromErr(err)
 	}
 
-	slaPolicy, err := zd.GetSLAPolicy(ctx, id)
+	var result struct {
+		SLAPolicy slaPolicy `json:"sla_policy"`
+	}
+	err = getJSON(ctx, zd, fmt.Sprintf("/slas/policies/%d.json", id), &result)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	err = marshalSLAPolicy(slaPolicy, d)
+	err = marshalSLAPolicy(result.SLAPolicy, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -273,7 +572,7 @@ func readSLAPolicy(ctx context.Context, d identifiableGetterSetter, zd client.SL
 	return diags
 }
 
-func updateSLAPolicy(ctx context.Context, d identifiableGetterSetter, zd client.SLAPolicyAPI) diag.Diagnostics {
+func updateSLAPolicy(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	slaPolicy, err := unmarshalSLAPolicy(d)
@@ -286,7 +585,7 @@ func updateSLAPolicy(ctx context.Context, d identifiableGetterSetter, zd client.
 		return diag.FromErr(err)
 	}
 
-	slaPolicy, err = zd.UpdateSLAPolicy(ctx, id, slaPolicy)
+	slaPolicy, err = putSLAPolicy(ctx, zd, fmt.Sprintf("/slas/policies/%d.json", id), slaPolicy)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -299,7 +598,7 @@ func updateSLAPolicy(ctx context.Context, d identifiableGetterSetter, zd client.
 	return diags
 }
 
-func deleteSLAPolicy(ctx context.Context, d identifiable, zd client.SLAPolicyAPI) diag.Diagnostics {
+func deleteSLAPolicy(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	id, err := atoi64(d.Id())
@@ -307,7 +606,7 @@ func deleteSLAPolicy(ctx context.Context, d identifiable, zd client.SLAPolicyAPI
 		return diag.FromErr(err)
 	}
 
-	err = zd.DeleteSLAPolicy(ctx, id)
+	err = zd.Delete(ctx, fmt.Sprintf("/slas/policies/%d.json", id))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -315,6 +614,26 @@ func deleteSLAPolicy(ctx context.Context, d identifiable, zd client.SLAPolicyAPI
 	return diags
 }
 
+func postSLAPolicy(ctx context.Context, zd client.BaseAPI, path string, in slaPolicy) (slaPolicy, error) {
+	var data, result struct {
+		SLAPolicy slaPolicy `json:"sla_policy"`
+	}
+	data.SLAPolicy = in
+
+	err := postJSON(ctx, zd, path, data, &result)
+	return result.SLAPolicy, err
+}
+
+func putSLAPolicy(ctx context.Context, zd client.BaseAPI, path string, in slaPolicy) (slaPolicy, error) {
+	var data, result struct {
+		SLAPolicy slaPolicy `json:"sla_policy"`
+	}
+	data.SLAPolicy = in
+
+	err := putJSON(ctx, zd, path, data, &result)
+	return result.SLAPolicy, err
+}
+
 func slaPolicyFilterSchema(desc string) *schema.Schema {
 	return &schema.Schema{
 		Description: desc,
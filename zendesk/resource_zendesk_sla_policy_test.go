@@ -14,10 +14,16 @@ import (
 )
 
 func TestMarshalSLAPolicy(t *testing.T) {
-	expected := zendesk.SLAPolicy{
-		Title:       "title",
-		Description: "blabla",
-		Active:      true,
+	expected := slaPolicy{
+		SLAPolicy: zendesk.SLAPolicy{
+			Title:       "title",
+			Description: "blabla",
+		},
+		MetricSettings: &slaPolicyMetricSettings{
+			FirstReplyTime: &slaPolicyFirstReplyTimeSettings{
+				ActivateOnTicketCreatedForEndUser: true,
+			},
+		},
 	}
 	m := &identifiableMapGetterSetter{
 		mapGetterSetter: mapGetterSetter{},
@@ -44,12 +50,13 @@ func TestMarshalSLAPolicy(t *testing.T) {
 		t.Fatalf("sla policy had incorrect description value %v. should have been %v", v, expected.Description)
 	}
 
-	v, ok = m.GetOk("active")
+	v, ok = m.GetOk("metric_settings")
 	if !ok {
-		t.Fatal("Failed to get active value")
+		t.Fatal("Failed to get metric_settings value")
 	}
-	if v != expected.Active {
-		t.Fatalf("sla policy had incorrect active value %v. should have been %v", v, expected.Active)
+	frt := v.([]map[string]interface{})[0]["first_reply_time"].([]map[string]interface{})[0]
+	if frt["activate_on_ticket_created_for_end_user"] != true {
+		t.Fatalf("sla policy had incorrect first_reply_time settings %v", frt)
 	}
 }
 
@@ -59,7 +66,20 @@ func TestUnmarshalSLAPolicy(t *testing.T) {
 		mapGetterSetter: mapGetterSetter{
 			"title":       "Auto reply",
 			"description": "reply automatically",
-			"active":      true,
+			"metric_settings": []interface{}{
+				map[string]interface{}{
+					"next_reply_time": []interface{}{
+						map[string]interface{}{
+							"fulfill_on_non_requesting_agent_internal_note_after_activation": true,
+							"activate_on_end_user_added_internal_note":                       false,
+							"activate_on_agent_requested_ticket_created_for_end_user":        false,
+							"activate_on_light_agent_on_email_forward_ticket_from_end_user":  false,
+							"activate_on_agent_created_ticket_for_self":                      false,
+							"fulfill_on_agent_internal_note":                                 false,
+						},
+					},
+				},
+			},
 		},
 	}
 
@@ -71,6 +91,38 @@ func TestUnmarshalSLAPolicy(t *testing.T) {
 	if v := m.Get("title"); sla.Title != v {
 		t.Fatalf("sla policy had title value %v. should have been %v", sla.Title, v)
 	}
+
+	if sla.MetricSettings == nil || sla.MetricSettings.NextReplyTime == nil {
+		t.Fatal("sla policy did not have next_reply_time metric settings")
+	}
+
+	if !sla.MetricSettings.NextReplyTime.FulfillOnNonRequestingAgentInternalNoteAfterActivation {
+		t.Fatalf("sla policy had incorrect next_reply_time settings %v", sla.MetricSettings.NextReplyTime)
+	}
+
+	if sla.MetricSettings.FirstReplyTime != nil {
+		t.Fatalf("sla policy should not have first_reply_time settings %v", sla.MetricSettings.FirstReplyTime)
+	}
+}
+
+func TestSLAPolicyStateUpgradeV0(t *testing.T) {
+	v0 := map[string]interface{}{
+		"title":  "Incidents",
+		"active": true,
+	}
+
+	v1, err := resourceZendeskSLAPolicyStateUpgradeV0(context.Background(), v0, nil)
+	if err != nil {
+		t.Fatalf("state upgrade returned an error: %v", err)
+	}
+
+	if _, ok := v1["active"]; ok {
+		t.Fatal("state upgrade did not remove active")
+	}
+
+	if v := v1["title"]; v != "Incidents" {
+		t.Fatalf("state upgrade changed title to %v", v)
+	}
 }
 
 func TestCreateSLAPolicy(t *testing.T) {
@@ -79,12 +131,9 @@ func TestCreateSLAPolicy(t *testing.T) {
 
 	m := mock.NewClient(ctrl)
 	i := newIdentifiableGetterSetter()
-	out := zendesk.SLAPolicy{
-		ID:    12345,
-		Title: "sla policy",
-	}
+	out := []byte(`{"sla_policy": {"id": 12345, "title": "sla policy"}}`)
 
-	m.EXPECT().CreateSLAPolicy(gomock.Any(), gomock.Any()).Return(out, nil)
+	m.EXPECT().Post(gomock.Any(), gomock.Eq("/slas/policies.json"), gomock.Any()).Return(out, nil)
 	if diags := createSLAPolicy(context.Background(), i, m); len(diags) != 0 {
 		t.Fatal("CreateSLAPolicy return an error")
 	}
@@ -106,18 +155,15 @@ func TestReadSLAPolicy(t *testing.T) {
 	i := newIdentifiableGetterSetter()
 	i.SetId("12345")
 
-	expected := zendesk.SLAPolicy{
-		Title:  "sla policy",
-		Active: true,
-	}
-	m.EXPECT().GetSLAPolicy(gomock.Any(), gomock.Eq(int64(12345))).Return(expected, nil)
+	expected := []byte(`{"sla_policy": {"title": "sla policy", "metric_settings": {"periodic_update_time": {"activate_on_agent_internal_note": true}}}}`)
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/slas/policies/12345.json")).Return(expected, nil)
 	if diags := readSLAPolicy(context.Background(), i, m); len(diags) != 0 {
 		t.Fatalf("GetSLAPolicy received an error when calling: %v", diags)
 	}
 
-	active := i.Get("active").(bool)
-	if !active {
-		t.Fatal("Did not set active field")
+	settings := i.Get("metric_settings").([]map[string]interface{})
+	if len(settings) != 1 || settings[0]["periodic_update_time"] == nil {
+		t.Fatalf("Did not set metric_settings field: %v", settings)
 	}
 }
 
@@ -131,7 +177,7 @@ func TestUpdateSLAPolicy(t *testing.T) {
 		mapGetterSetter: mapGetterSetter{},
 	}
 
-	m.EXPECT().UpdateSLAPolicy(gomock.Any(), gomock.Eq(int64(12345)), gomock.Any()).Return(zendesk.SLAPolicy{}, nil)
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/slas/policies/12345.json"), gomock.Any()).Return([]byte(`{"sla_policy": {}}`), nil)
 	if diags := updateSLAPolicy(context.Background(), i, m); len(diags) != 0 {
 		t.Fatalf("updateSLAPolicy returned an error %v", diags)
 	}
@@ -146,7 +192,7 @@ func TestDeleteSLAPolicy(t *testing.T) {
 
 	d.SetId("1234")
 
-	c.EXPECT().DeleteSLAPolicy(gomock.Any(), gomock.Eq(int64(1234))).Return(nil)
+	c.EXPECT().Delete(gomock.Any(), gomock.Eq("/slas/policies/1234.json")).Return(nil)
 	diags := deleteSLAPolicy(context.Background(), d, c)
 	if len(diags) != 0 {
 		t.Fatalf("Got error from resource delete: %v", diags)
@@ -196,7 +242,7 @@ func TestAccSLAPolicyExample(t *testing.T) {
 				Config: readExampleConfig(t, "resources/zendesk_sla_policy/resource.tf"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("zendesk_sla_policy.incidents_sla_policy", "title", "Incidents"),
-					resource.TestCheckResourceAttr("zendesk_sla_policy.incidents_sla_policy", "active", "true"),
+					resource.TestCheckResourceAttr("zendesk_sla_policy.incidents_sla_policy", "policy_metrics.#", "1"),
 					resource.TestCheckResourceAttrSet("zendesk_sla_policy.incidents_sla_policy", "all.#"),
 				),
 			},
@@ -0,0 +1,331 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+)
+
+// supportAddress is the support address (recipient address) JSON payload format
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/support_addresses/#json-format
+type supportAddress struct {
+	ID               int64      `json:"id,omitempty"`
+	BrandID          int64      `json:"brand_id,omitempty"`
+	Default          bool       `json:"default,omitempty"`
+	Email            string     `json:"email,omitempty"`
+	Name             string     `json:"name,omitempty"`
+	ForwardingStatus string     `json:"forwarding_status,omitempty"`
+	SPFStatus        string     `json:"spf_status,omitempty"`
+	CNAMEStatus      string     `json:"cname_status,omitempty"`
+	CreatedAt        *time.Time `json:"created_at,omitempty"`
+	UpdatedAt        *time.Time `json:"updated_at,omitempty"`
+}
+
+// Interval between forwarding verification checks while waiting on create
+var supportAddressVerificationPollInterval = 30 * time.Second
+
+// https://developer.zendesk.com/api-reference/ticketing/account-configuration/support_addresses/
+func resourceZendeskSupportAddress() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a support address (recipient address) resource.",
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return createSupportAddress(ctx, d, zd)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return readSupportAddress(ctx, d, zd)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return updateSupportAddress(ctx, d, zd)
+		},
+		DeleteContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			zd := i.(client.BaseAPI)
+			return deleteSupportAddress(ctx, d, zd)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, i interface{}) error {
+			// plan an update of addresses still waiting for verification, so that it is retried
+			if d.Id() == "" || !supportAddressNeedsVerification(d) {
+				return nil
+			}
+			return d.SetNewComputed("forwarding_status")
+		},
+
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Description: "The email address of the support address. It cannot be changed after creation.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the support address, displayed as the sender name of outgoing emails.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"brand_id": {
+				Description: "The id of the brand the support address belongs to. Defaults to the default brand.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"default": {
+				Description: "Whether the support address is the default support address of the account.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"verification_timeout": {
+				Description: `If set, creation waits up to this duration (e.g. "15m") for email forwarding to be verified. ` +
+					"An address which is not verified in time is kept with a warning, and the next apply waits for the verification again.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: isValidDuration(),
+			},
+			"forwarding_status": {
+				Description: `Whether email forwarding to Zendesk is set up. Possible values are "unknown", "waiting", "verified", or "failed".`,
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"spf_status": {
+				Description: `Whether the SPF record of the domain is set up. Possible values are "unknown", "verified", or "failed".`,
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"cname_status": {
+				Description: `Whether the CNAME records of the domain are set up. Possible values are "unknown", "verified", or "failed".`,
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// supportAddressNeedsVerification tells whether the forwarding of an address waited for is not verified yet
+func supportAddressNeedsVerification(d getter) bool {
+	if _, ok := d.GetOk("verification_timeout"); !ok {
+		return false
+	}
+	status, _ := d.Get("forwarding_status").(string)
+	return status != "verified"
+}
+
+func marshalSupportAddress(address supportAddress, d identifiableGetterSetter) error {
+	fields := map[string]interface{}{
+		"email":             address.Email,
+		"name":              address.Name,
+		"brand_id":          address.BrandID,
+		"default":           address.Default,
+		"forwarding_status": address.ForwardingStatus,
+		"spf_status":        address.SPFStatus,
+		"cname_status":      address.CNAMEStatus,
+	}
+
+	return setSchemaFields(d, fields)
+}
+
+func unmarshalSupportAddress(d identifiableGetterSetter) (supportAddress, error) {
+	address := supportAddress{}
+
+	if v := d.Id(); v != "" {
+		id, err := atoi64(v)
+		if err != nil {
+			return address, fmt.Errorf("could not parse support address id %s: %v", v, err)
+		}
+		address.ID = id
+	}
+
+	if v, ok := d.GetOk("email"); ok {
+		address.Email = v.(string)
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		address.Name = v.(string)
+	}
+
+	if v, ok := d.GetOk("brand_id"); ok {
+		address.BrandID = int64(v.(int))
+	}
+
+	if v, ok := d.GetOk("default"); ok {
+		address.Default = v.(bool)
+	}
+
+	return address, nil
+}
+
+func createSupportAddress(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	address, err := unmarshalSupportAddress(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var data, result struct {
+		RecipientAddress supportAddress `json:"recipient_address"`
+	}
+	data.RecipientAddress = address
+
+	err = postJSON(ctx, zd, "/recipient_addresses.json", data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	address = result.RecipientAddress
+	d.SetId(fmt.Sprintf("%d", address.ID))
+
+	address, diags = verifySupportAddress(ctx, d, zd, address)
+
+	err = marshalSupportAddress(address, d)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func readSupportAddress(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	address, err := getSupportAddress(ctx, zd, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = marshalSupportAddress(address, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func updateSupportAddress(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	address, err := unmarshalSupportAddress(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var data, result struct {
+		RecipientAddress supportAddress `json:"recipient_address"`
+	}
+	data.RecipientAddress = address
+
+	err = putJSON(ctx, zd, fmt.Sprintf("/recipient_addresses/%d.json", address.ID), data, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	address, diags = verifySupportAddress(ctx, d, zd, result.RecipientAddress)
+
+	err = marshalSupportAddress(address, d)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func deleteSupportAddress(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id, err := atoi64(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = zd.Delete(ctx, fmt.Sprintf("/recipient_addresses/%d.json", id))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func getSupportAddress(ctx context.Context, zd client.BaseAPI, id int64) (supportAddress, error) {
+	var result struct {
+		RecipientAddress supportAddress `json:"recipient_address"`
+	}
+
+	err := getJSON(ctx, zd, fmt.Sprintf("/recipient_addresses/%d.json", id), &result)
+	return result.RecipientAddress, err
+}
+
+// verifySupportAddress waits for the forwarding verification of the address when verification_timeout is set.
+// An address which is not verified in time is kept with a warning, and the next apply waits again.
+func verifySupportAddress(ctx context.Context, d getter, zd client.BaseAPI, address supportAddress) (supportAddress, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	v, ok := d.GetOk("verification_timeout")
+	if !ok || address.ForwardingStatus == "verified" {
+		return address, diags
+	}
+
+	timeout, err := time.ParseDuration(v.(string))
+	if err != nil {
+		return address, diag.FromErr(err)
+	}
+
+	verified, err := waitForSupportAddressVerification(ctx, zd, address.ID, timeout)
+	if err != nil {
+		return address, append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("support address %s was not verified", address.Email),
+			Detail:   fmt.Sprintf("email forwarding was not verified: %v. the next apply waits for the verification again.", err),
+		})
+	}
+
+	return verified, diags
+}
+
+// waitForSupportAddressVerification requests forwarding verification until it succeeds or the timeout expires
+func waitForSupportAddressVerification(ctx context.Context, zd client.BaseAPI, id int64, timeout time.Duration) (supportAddress, error) {
+	conf := &retry.StateChangeConf{
+		Pending: []string{"unknown", "waiting", "failed"},
+		Target:  []string{"verified"},
+		Refresh: func() (interface{}, string, error) {
+			data := map[string]interface{}{
+				"type": "forwarding",
+			}
+			err := putJSON(ctx, zd, fmt.Sprintf("/recipient_addresses/%d/verify.json", id), data, nil)
+			if err != nil {
+				return nil, "", err
+			}
+
+			address, err := getSupportAddress(ctx, zd, id)
+			if err != nil {
+				return nil, "", err
+			}
+
+			return address, address.ForwardingStatus, nil
+		},
+		Timeout:      timeout,
+		PollInterval: supportAddressVerificationPollInterval,
+	}
+
+	out, err := conf.WaitForStateContext(ctx)
+	if err != nil {
+		return supportAddress{}, err
+	}
+
+	return out.(supportAddress), nil
+}
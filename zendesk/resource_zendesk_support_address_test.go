@@ -0,0 +1,301 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nukosuke/go-zendesk/zendesk"
+	"github.com/nukosuke/go-zendesk/zendesk/mock"
+)
+
+func TestMarshalSupportAddress(t *testing.T) {
+	expected := supportAddress{
+		Email:            "support@example.com",
+		Name:             "Example Support",
+		BrandID:          123,
+		ForwardingStatus: "verified",
+		SPFStatus:        "failed",
+		CNAMEStatus:      "unknown",
+	}
+	m := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{},
+	}
+
+	err := marshalSupportAddress(expected, m)
+	if err != nil {
+		t.Fatalf("Failed to marshal map %v", err)
+	}
+
+	v, ok := m.GetOk("email")
+	if !ok {
+		t.Fatal("Failed to get email value")
+	}
+	if v != expected.Email {
+		t.Fatalf("support address had incorrect email value %v. should have been %v", v, expected.Email)
+	}
+
+	v, ok = m.GetOk("spf_status")
+	if !ok {
+		t.Fatal("Failed to get spf_status value")
+	}
+	if v != expected.SPFStatus {
+		t.Fatalf("support address had incorrect spf_status value %v. should have been %v", v, expected.SPFStatus)
+	}
+}
+
+func TestUnmarshalSupportAddress(t *testing.T) {
+	m := &identifiableMapGetterSetter{
+		id: "100",
+		mapGetterSetter: mapGetterSetter{
+			"email":    "support@example.com",
+			"name":     "Example Support",
+			"brand_id": 123,
+		},
+	}
+
+	address, err := unmarshalSupportAddress(m)
+	if err != nil {
+		t.Fatalf("unmarshal returned an error: %v", err)
+	}
+
+	if address.ID != 100 {
+		t.Fatalf("support address had id value %v. should have been 100", address.ID)
+	}
+
+	if address.BrandID != 123 {
+		t.Fatalf("support address had brand_id value %v. should have been 123", address.BrandID)
+	}
+}
+
+func TestCreateSupportAddress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	out := []byte(`{"recipient_address": {"id": 12345, "email": "support@example.com", "forwarding_status": "unknown"}}`)
+
+	m.EXPECT().Post(gomock.Any(), gomock.Eq("/recipient_addresses.json"), gomock.Any()).Return(out, nil)
+	if diags := createSupportAddress(context.Background(), i, m); len(diags) != 0 {
+		t.Fatal("createSupportAddress return an error")
+	}
+
+	if v := i.Id(); v != "12345" {
+		t.Fatalf("createSupportAddress did not set resource id. Id was %s", v)
+	}
+
+	if v := i.Get("forwarding_status"); v != "unknown" {
+		t.Fatalf("createSupportAddress did not set resource forwarding_status. forwarding_status was %s", v)
+	}
+}
+
+func TestCreateSupportAddressWaitsForVerification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	interval := supportAddressVerificationPollInterval
+	supportAddressVerificationPollInterval = time.Millisecond
+	defer func() { supportAddressVerificationPollInterval = interval }()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	if err := i.Set("verification_timeout", "1m"); err != nil {
+		t.Fatal(err)
+	}
+
+	created := []byte(`{"recipient_address": {"id": 12345, "email": "support@example.com", "forwarding_status": "unknown"}}`)
+	waiting := []byte(`{"recipient_address": {"id": 12345, "email": "support@example.com", "forwarding_status": "waiting"}}`)
+	verified := []byte(`{"recipient_address": {"id": 12345, "email": "support@example.com", "forwarding_status": "verified"}}`)
+
+	m.EXPECT().Post(gomock.Any(), gomock.Eq("/recipient_addresses.json"), gomock.Any()).Return(created, nil)
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/recipient_addresses/12345/verify.json"), gomock.Any()).Return(nil, nil).Times(2)
+	gomock.InOrder(
+		m.EXPECT().Get(gomock.Any(), gomock.Eq("/recipient_addresses/12345.json")).Return(waiting, nil),
+		m.EXPECT().Get(gomock.Any(), gomock.Eq("/recipient_addresses/12345.json")).Return(verified, nil),
+	)
+
+	if diags := createSupportAddress(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("createSupportAddress return an error %v", diags)
+	}
+
+	if v := i.Get("forwarding_status"); v != "verified" {
+		t.Fatalf("createSupportAddress did not wait for verification. forwarding_status was %s", v)
+	}
+}
+
+func TestCreateSupportAddressWarnsWhenNotVerified(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	interval := supportAddressVerificationPollInterval
+	supportAddressVerificationPollInterval = time.Millisecond
+	defer func() { supportAddressVerificationPollInterval = interval }()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	if err := i.Set("verification_timeout", "10ms"); err != nil {
+		t.Fatal(err)
+	}
+
+	created := []byte(`{"recipient_address": {"id": 12345, "email": "support@example.com", "forwarding_status": "unknown"}}`)
+	waiting := []byte(`{"recipient_address": {"id": 12345, "email": "support@example.com", "forwarding_status": "waiting"}}`)
+
+	m.EXPECT().Post(gomock.Any(), gomock.Eq("/recipient_addresses.json"), gomock.Any()).Return(created, nil)
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/recipient_addresses/12345/verify.json"), gomock.Any()).Return(nil, nil).AnyTimes()
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/recipient_addresses/12345.json")).Return(waiting, nil).AnyTimes()
+
+	diags := createSupportAddress(context.Background(), i, m)
+	if diags.HasError() {
+		t.Fatalf("createSupportAddress returned an error for an address not verified in time: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("createSupportAddress did not warn about the address not verified in time: %v", diags)
+	}
+
+	if v := i.Id(); v != "12345" {
+		t.Fatalf("createSupportAddress did not keep the address in state. Id was %s", v)
+	}
+
+	if !supportAddressNeedsVerification(i) {
+		t.Fatalf("supportAddressNeedsVerification returned false for an address not verified yet")
+	}
+}
+
+func TestUpdateSupportAddressRetriesVerification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	interval := supportAddressVerificationPollInterval
+	supportAddressVerificationPollInterval = time.Millisecond
+	defer func() { supportAddressVerificationPollInterval = interval }()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "12345",
+		mapGetterSetter: mapGetterSetter{
+			"email":                "support@example.com",
+			"verification_timeout": "1m",
+			"forwarding_status":    "waiting",
+		},
+	}
+
+	waiting := []byte(`{"recipient_address": {"id": 12345, "email": "support@example.com", "forwarding_status": "waiting"}}`)
+	verified := []byte(`{"recipient_address": {"id": 12345, "email": "support@example.com", "forwarding_status": "verified"}}`)
+
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/recipient_addresses/12345.json"), gomock.Any()).Return(waiting, nil)
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/recipient_addresses/12345/verify.json"), gomock.Any()).Return(nil, nil)
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/recipient_addresses/12345.json")).Return(verified, nil)
+
+	if diags := updateSupportAddress(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateSupportAddress returned an error %v", diags)
+	}
+
+	if supportAddressNeedsVerification(i) {
+		t.Fatalf("updateSupportAddress did not wait for verification. forwarding_status was %s", i.Get("forwarding_status"))
+	}
+}
+
+func TestReadSupportAddress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("12345")
+
+	out := []byte(`{"recipient_address": {"id": 12345, "email": "support@example.com", "cname_status": "verified"}}`)
+	m.EXPECT().Get(gomock.Any(), gomock.Eq("/recipient_addresses/12345.json")).Return(out, nil)
+	if diags := readSupportAddress(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("readSupportAddress received an error when calling: %v", diags)
+	}
+
+	if v := i.Get("cname_status"); v != "verified" {
+		t.Fatalf("readSupportAddress did not set resource cname_status. cname_status was %s", v)
+	}
+}
+
+func TestUpdateSupportAddress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id:              "12345",
+		mapGetterSetter: mapGetterSetter{},
+	}
+
+	m.EXPECT().Put(gomock.Any(), gomock.Eq("/recipient_addresses/12345.json"), gomock.Any()).Return([]byte(`{"recipient_address": {}}`), nil)
+	if diags := updateSupportAddress(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("updateSupportAddress returned an error %v", diags)
+	}
+}
+
+func TestDeleteSupportAddress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c := mock.NewClient(ctrl)
+	d := newIdentifiableGetterSetter()
+
+	d.SetId("1234")
+
+	c.EXPECT().Delete(gomock.Any(), gomock.Eq("/recipient_addresses/1234.json")).Return(nil)
+	diags := deleteSupportAddress(context.Background(), d, c)
+	if len(diags) != 0 {
+		t.Fatalf("Got error from resource delete: %v", diags)
+	}
+}
+
+func testSupportAddressDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(zendesk.BaseAPI)
+
+	for k, r := range s.RootModule().Resources {
+		if r.Type != "zendesk_support_address" {
+			continue
+		}
+
+		_, err := client.Get(context.Background(), fmt.Sprintf("/recipient_addresses/%s.json", r.Primary.ID))
+		if err == nil {
+			return fmt.Errorf("did not get error from zendesk when trying to fetch the destroyed support address. resource name %s", k)
+		}
+
+		zdresp, ok := err.(zendesk.Error)
+		if !ok {
+			return fmt.Errorf("error %v cannot be asserted as a zendesk error", err)
+		}
+
+		if zdresp.Status() != http.StatusNotFound {
+			return fmt.Errorf("did not get a not found error after destroy. error was %v", zdresp)
+		}
+	}
+	return nil
+}
+
+func TestAccSupportAddressExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testSupportAddressDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: concatExampleConfig(t,
+					readExampleConfig(t, "resources/zendesk_brand/resource.tf"),
+					readExampleConfig(t, "resources/zendesk_support_address/resource.tf"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("zendesk_support_address.support", "brand_id"),
+					resource.TestCheckResourceAttrSet("zendesk_support_address.support", "forwarding_status"),
+				),
+			},
+		},
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -40,23 +41,10 @@ func resourceZendeskTarget() *schema.Resource {
 				Computed: true,
 			},
 			"type": {
-				Type:     schema.TypeString,
-				Required: true,
-				ValidateFunc: validation.StringInSlice([]string{
-					//"basecamp_target",
-					//"campfire_target",
-					//"clickatell_target",
-					"email_target",
-					//"flowdock_target",
-					//"get_satisfaction_target",
-					//"jira_target",
-					//"pivotal_target",
-					//"twitter_target",
-					//"url_target",
-					"http_target",   // DEPRECATED. will be removed in future.
-					"url_target_v2", // DEPRECATED. synonym of http_target
-					//"yammer_target",
-				}, false),
+				Description:      "The type of the target. `http_target` and its synonym `url_target_v2` are deprecated in favor of `zendesk_webhook`, and planning them warns.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateTargetType,
 			},
 			"title": {
 				Description: "A name for the target.",
@@ -125,6 +113,43 @@ func resourceZendeskTarget() *schema.Resource {
 	}
 }
 
+// Types of the targets which can be managed
+var targetTypes = []string{
+	//"basecamp_target",
+	//"campfire_target",
+	//"clickatell_target",
+	"email_target",
+	//"flowdock_target",
+	//"get_satisfaction_target",
+	//"jira_target",
+	//"pivotal_target",
+	//"twitter_target",
+	//"url_target",
+	"http_target",   // DEPRECATED. will be removed in future.
+	"url_target_v2", // DEPRECATED. synonym of http_target
+	//"yammer_target",
+}
+
+// validateTargetType validates the type of the target and warns about the deprecated HTTP targets,
+// so they can be migrated to webhooks before they are removed
+func validateTargetType(i interface{}, path cty.Path) diag.Diagnostics {
+	diags := validation.ToDiagFunc(validation.StringInSlice(targetTypes, false))(i, path)
+	if diags.HasError() {
+		return diags
+	}
+
+	if v, _ := i.(string); v == "http_target" || v == "url_target_v2" {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       fmt.Sprintf("%s is deprecated", v),
+			Detail:        "HTTP targets are deprecated in favor of webhooks and will be removed. Use the zendesk_target_webhook_migration data source to migrate the target and its trigger actions to a zendesk_webhook.",
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
 func marshalTarget(target client.Target, d identifiableGetterSetter) error {
 	fields := map[string]interface{}{
 		"url":    target.URL,
@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	. "github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/nukosuke/go-zendesk/zendesk"
 	"github.com/nukosuke/go-zendesk/zendesk/mock"
 )
@@ -211,3 +213,22 @@ func TestDeleteTarget(t *testing.T) {
 		t.Fatalf("deleteTarget returned an error: %v", diags)
 	}
 }
+
+func TestValidateTargetType(t *testing.T) {
+	path := cty.GetAttrPath("type")
+
+	if diags := validateTargetType("email_target", path); len(diags) != 0 {
+		t.Fatalf("validateTargetType returned diagnostics for email_target: %v", diags)
+	}
+
+	for _, v := range []string{"http_target", "url_target_v2"} {
+		diags := validateTargetType(v, path)
+		if len(diags) != 1 || diags[0].Severity != diag.Warning {
+			t.Fatalf("validateTargetType did not warn about %s: %v", v, diags)
+		}
+	}
+
+	if diags := validateTargetType("twitter_target", path); !diags.HasError() {
+		t.Fatalf("validateTargetType did not reject twitter_target: %v", diags)
+	}
+}
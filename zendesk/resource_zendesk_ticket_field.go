@@ -3,14 +3,44 @@ package zendesk
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	client "github.com/nukosuke/go-zendesk/zendesk"
 )
 
+// ticketField is the ticket field payload including the lookup relationship fields not yet supported by the client
+type ticketField struct {
+	client.TicketField
+	RelationshipTargetType string                   `json:"relationship_target_type,omitempty"`
+	RelationshipFilter     *fieldRelationshipFilter `json:"relationship_filter,omitempty"`
+}
+
+// ref: https://developer.zendesk.com/api-reference/ticketing/lookup_relationships/lookup_relationships/#filter
+type fieldRelationshipFilter struct {
+	All []fieldRelationshipCondition `json:"all"`
+	Any []fieldRelationshipCondition `json:"any"`
+}
+
+type fieldRelationshipCondition struct {
+	Field    string `json:"field"`
+	Operator string `json:"operator"`
+	Value    string `json:"value,omitempty"`
+}
+
+// ticketFieldAPI is the part of the client used by the ticket field data source
+type ticketFieldAPI interface {
+	client.TicketFieldAPI
+	client.BaseAPI
+}
+
+// Targets of a lookup relationship field. Custom objects are referenced as "zen:custom_object:<key>".
+var fieldRelationshipTargetTypeRegexp = regexp.MustCompile(`^zen:(user|organization|ticket|custom_object:[a-z0-9_]+)$`)
+
 // https://developer.zendesk.com/rest_api/docs/core/ticket_fields
 func resourceZendeskTicketField() *schema.Resource {
 	return &schema.Resource{
@@ -22,6 +52,14 @@ func resourceZendeskTicketField() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, i interface{}) error {
+			for _, k := range customFieldValidatedKeys {
+				if !d.NewValueKnown(k) {
+					return nil
+				}
+			}
+			return validateTicketField(d)
+		},
 
 		Schema: map[string]*schema.Schema{
 			"url": {
@@ -33,11 +71,13 @@ func resourceZendeskTicketField() *schema.Resource {
 				Description: "System or custom field type. Editable for custom field types and only on creation.",
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				ValidateFunc: validation.StringInSlice([]string{
 					"checkbox",
 					"date",
 					"decimal",
 					"integer",
+					"lookup",
 					"multiselect",
 					"partialcreditcard",
 					"regexp",
@@ -86,8 +126,6 @@ func resourceZendeskTicketField() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				// Regular expression field only
-				//TODO: validation
 			},
 			"title_in_portal": {
 				Description: "The title of the ticket field for end users in Help Center.",
@@ -135,37 +173,13 @@ func resourceZendeskTicketField() *schema.Resource {
 				Computed: true,
 			},
 			// https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_fields/#updating-drop-down-field-options
-			"custom_field_option": {
-				Description: `Required and presented for a custom ticket field of type "multiselect" or "tagger".`,
-				Type:        schema.TypeSet,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Description: "Custom field option name.",
-							Type:        schema.TypeString,
-							Required:    true,
-						},
-						"value": {
-							Description: "Custom field option value.",
-							Type:        schema.TypeString,
-							Required:    true,
-						},
-						"id": {
-							Description: "Custom field option id.",
-							Type:        schema.TypeInt,
-							Computed:    true,
-						},
-					},
-				},
-				Optional: true,
-				//TODO: empty is invalid form
-			},
+			"custom_field_option": customFieldOptionSchema(`Required and presented for a custom ticket field of type "multiselect" or "tagger". Options are displayed in the listed order and are identified by their value, so renaming an option keeps its id.`),
 			// "priority" and "status" fields only
 			"sub_type_id": {
 				Description: `For system ticket fields of type "priority" and "status". Defaults to 0. A "priority" sub type of 1 removes the "Low" and "Urgent" options. A "status" sub type of 1 adds the "On-Hold" option.`,
 				Type:        schema.TypeInt,
 				Optional:    true,
-				//TODO: validation
+				Deprecated:  `sub_type_id only applies to system "priority" and "status" fields, which can't be managed with this resource. Only 0 is accepted.`,
 			},
 			// NOTE: Maybe this is not necessary because it's only for system field
 			"removable": {
@@ -178,12 +192,170 @@ func resourceZendeskTicketField() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"relationship_target_type": fieldRelationshipTargetTypeSchema(),
+			"relationship_filter":      fieldRelationshipFilterSchema(),
+		},
+	}
+}
+
+func customFieldOptionSchema(desc string) *schema.Schema {
+	return &schema.Schema{
+		Description: desc,
+		Type:        schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Description: "Custom field option name.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"value": {
+					Description: "Custom field option value.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"id": {
+					Description: "Custom field option id.",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+			},
 		},
+		Optional: true,
 	}
 }
 
+func fieldRelationshipTargetTypeSchema() *schema.Schema {
+	return &schema.Schema{
+		Description:  `For "lookup" fields only. The type of object the field references: "zen:user", "zen:organization", "zen:ticket", or "zen:custom_object:<key>".`,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringMatch(fieldRelationshipTargetTypeRegexp, `must be "zen:user", "zen:organization", "zen:ticket", or "zen:custom_object:<key>"`),
+	}
+}
+
+func fieldRelationshipFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: `For "lookup" fields only. Conditions the referenced objects must meet to be selectable.`,
+		Type:        schema.TypeList,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"all": fieldRelationshipConditionSchema("Logical AND. All the conditions must be met."),
+				"any": fieldRelationshipConditionSchema("Logical OR. Any condition can be met."),
+			},
+		},
+		Optional: true,
+	}
+}
+
+func fieldRelationshipConditionSchema(desc string) *schema.Schema {
+	return &schema.Schema{
+		Description: desc,
+		Type:        schema.TypeSet,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"field": {
+					Description: "The name of a field of the referenced object.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"operator": {
+					Description: "A comparison operator.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"value": {
+					Description: "The value to compare the field with. Some operators, e.g. \"present\", take no value.",
+					Type:        schema.TypeString,
+					Optional:    true,
+				},
+			},
+		},
+		Optional: true,
+	}
+}
+
+// validateTicketField checks the attributes that only apply to some types of ticket fields
+func validateTicketField(d getter) error {
+	fieldType := d.Get("type").(string)
+
+	if v, ok := d.GetOk("sub_type_id"); ok && v.(int) != 0 {
+		return fmt.Errorf(`sub_type_id can only be set on "priority" and "status" fields, not on %q fields`, fieldType)
+	}
+
+	return validateCustomField(d, fieldType)
+}
+
+// customFieldValidatedKeys are the attributes validateCustomField needs to know.
+// A lookup field can target a custom object created in the same apply, so the
+// relationship attributes may only be known after apply.
+var customFieldValidatedKeys = []string{"type", "custom_field_option", "relationship_target_type", "relationship_filter"}
+
+// validateCustomField checks the attributes ticket fields and custom object fields
+// have in common, which only apply to some types of fields
+func validateCustomField(d getter, fieldType string) error {
+	if pattern, ok := fieldRegexpForValidation(d); ok {
+		if fieldType != "regexp" {
+			return fmt.Errorf(`regexp_for_validation can only be set on "regexp" fields, not on %q fields`, fieldType)
+		}
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("regexp_for_validation is not a valid regular expression: %w", err)
+		}
+	}
+
+	if _, ok := d.GetOk("tag"); ok && fieldType != "checkbox" {
+		return fmt.Errorf(`tag can only be set on "checkbox" fields, not on %q fields`, fieldType)
+	}
+
+	if fieldType == "tagger" || fieldType == "dropdown" || fieldType == "multiselect" {
+		if v, ok := d.GetOk("custom_field_option"); !ok || len(v.([]interface{})) == 0 {
+			return fmt.Errorf("custom_field_option is required for %q fields", fieldType)
+		}
+	}
+
+	if fieldType == "lookup" {
+		if v, ok := d.GetOk("relationship_target_type"); !ok || v.(string) == "" {
+			return fmt.Errorf(`relationship_target_type is required for "lookup" fields`)
+		}
+		return nil
+	}
+
+	for _, k := range []string{"relationship_target_type", "relationship_filter"} {
+		if _, ok := d.GetOk(k); ok {
+			return fmt.Errorf(`%s can only be set on "lookup" fields, not on %q fields`, k, fieldType)
+		}
+	}
+
+	return nil
+}
+
+// fieldRegexpForValidation returns the configured validation pattern.
+// The attribute can be computed, so a plan also carries the value from the state, which is
+// stale when the field is being replaced by one of another type. Read the configuration when it is available.
+func fieldRegexpForValidation(d getter) (string, bool) {
+	if c, ok := d.(interface{ GetRawConfig() cty.Value }); ok {
+		config := c.GetRawConfig()
+		if config.IsNull() || !config.IsKnown() {
+			return "", false
+		}
+		v := config.GetAttr("regexp_for_validation")
+		if v.IsNull() || !v.IsKnown() || v.AsString() == "" {
+			return "", false
+		}
+		return v.AsString(), true
+	}
+
+	v, ok := d.GetOk("regexp_for_validation")
+	if !ok {
+		return "", false
+	}
+	return v.(string), true
+}
+
 // marshalTicketField encodes the provided ticket field into the provided resource data
-func marshalTicketField(field client.TicketField, d identifiableGetterSetter) error {
+func marshalTicketField(field ticketField, d identifiableGetterSetter) error {
 	fields := map[string]interface{}{
 		"url":                   field.URL,
 		"type":                  field.Type,
@@ -216,18 +388,9 @@ func marshalTicketField(field client.TicketField, d identifiableGetterSetter) er
 
 	fields["system_field_options"] = systemFieldOptions
 
-	// Set custom field options
-	customFieldOptions := make([]map[string]interface{}, 0)
-	for _, v := range field.CustomFieldOptions {
-		m := map[string]interface{}{
-			"name":  v.Name,
-			"value": v.Value,
-			"id":    v.ID,
-		}
-		customFieldOptions = append(customFieldOptions, m)
-	}
-
-	fields["custom_field_option"] = customFieldOptions
+	fields["custom_field_option"] = marshalCustomFieldOptions(field.CustomFieldOptions)
+	fields["relationship_target_type"] = field.RelationshipTargetType
+	fields["relationship_filter"] = marshalFieldRelationshipFilter(field.RelationshipFilter)
 
 	err := setSchemaFields(d, fields)
 	if err != nil {
@@ -237,9 +400,44 @@ func marshalTicketField(field client.TicketField, d identifiableGetterSetter) er
 	return nil
 }
 
+func marshalCustomFieldOptions(options []client.CustomFieldOption) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(options))
+	for _, v := range options {
+		out = append(out, map[string]interface{}{
+			"name":  v.Name,
+			"value": v.Value,
+			"id":    v.ID,
+		})
+	}
+	return out
+}
+
+func marshalFieldRelationshipFilter(f *fieldRelationshipFilter) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0)
+	if f != nil && (len(f.All) > 0 || len(f.Any) > 0) {
+		out = append(out, map[string]interface{}{
+			"all": marshalFieldRelationshipConditions(f.All),
+			"any": marshalFieldRelationshipConditions(f.Any),
+		})
+	}
+	return out
+}
+
+func marshalFieldRelationshipConditions(conditions []fieldRelationshipCondition) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(conditions))
+	for _, c := range conditions {
+		out = append(out, map[string]interface{}{
+			"field":    c.Field,
+			"operator": c.Operator,
+			"value":    c.Value,
+		})
+	}
+	return out
+}
+
 // unmarshalTicketField parses the provided ResourceData and returns a ticket field
-func unmarshalTicketField(d identifiableGetterSetter) (client.TicketField, error) {
-	tf := client.TicketField{}
+func unmarshalTicketField(d identifiableGetterSetter) (ticketField, error) {
+	tf := ticketField{}
 
 	if v := d.Id(); v != "" {
 		id, err := strconv.ParseInt(v, 10, 64)
@@ -316,24 +514,11 @@ func unmarshalTicketField(d identifiableGetterSetter) (client.TicketField, error
 		tf.AgentDescription = v.(string)
 	}
 
-	if v, ok := d.GetOk("custom_field_option"); ok {
-		options := v.(*schema.Set).List()
-		customFieldOptions := make([]client.CustomFieldOption, 0)
-		for _, o := range options {
-			option, ok := o.(map[string]interface{})
-			if !ok {
-				return tf, fmt.Errorf("could not parse custom options for field %v", tf)
-			}
-
-			customFieldOptions = append(customFieldOptions, client.CustomFieldOption{
-				Name:  option["name"].(string),
-				Value: option["value"].(string),
-				ID:    int64(option["id"].(int)),
-			})
-		}
-
-		tf.CustomFieldOptions = customFieldOptions
+	customFieldOptions, err := unmarshalCustomFieldOptions(d)
+	if err != nil {
+		return tf, err
 	}
+	tf.CustomFieldOptions = customFieldOptions
 
 	if v, ok := d.GetOk("system_field_options"); ok {
 		options := v.(*schema.Set).List()
@@ -353,15 +538,118 @@ func unmarshalTicketField(d identifiableGetterSetter) (client.TicketField, error
 		tf.SystemFieldOptions = systemFieldOptions
 	}
 
+	if v, ok := d.GetOk("relationship_target_type"); ok {
+		tf.RelationshipTargetType = v.(string)
+	}
+
+	tf.RelationshipFilter = unmarshalFieldRelationshipFilter(d)
+
 	return tf, nil
 }
 
+// unmarshalCustomFieldOptions returns the configured options with the ids of the existing ones
+func unmarshalCustomFieldOptions(d getter) ([]client.CustomFieldOption, error) {
+	v, ok := d.GetOk("custom_field_option")
+	if !ok {
+		return nil, nil
+	}
+
+	options := v.([]interface{})
+	existingIDs := customFieldOptionIDs(d)
+	customFieldOptions := make([]client.CustomFieldOption, 0)
+	seen := make(map[string]bool)
+	for _, o := range options {
+		option, ok := o.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("could not parse custom field option %v", o)
+		}
+
+		value := option["value"].(string)
+		if seen[value] {
+			return nil, fmt.Errorf("custom field option value %q is used more than once", value)
+		}
+		seen[value] = true
+
+		customFieldOptions = append(customFieldOptions, client.CustomFieldOption{
+			Name:  option["name"].(string),
+			Value: value,
+			ID:    existingIDs[value],
+		})
+	}
+
+	return customFieldOptions, nil
+}
+
+func unmarshalFieldRelationshipFilter(d getter) *fieldRelationshipFilter {
+	v, ok := d.GetOk("relationship_filter")
+	if !ok {
+		return nil
+	}
+
+	filters := v.([]interface{})
+	if len(filters) == 0 || filters[0] == nil {
+		return nil
+	}
+
+	filter := filters[0].(map[string]interface{})
+	return &fieldRelationshipFilter{
+		All: unmarshalFieldRelationshipConditions(filter["all"]),
+		Any: unmarshalFieldRelationshipConditions(filter["any"]),
+	}
+}
+
+func unmarshalFieldRelationshipConditions(v interface{}) []fieldRelationshipCondition {
+	conditions := make([]fieldRelationshipCondition, 0)
+	set, ok := v.(*schema.Set)
+	if !ok {
+		return conditions
+	}
+
+	for _, c := range set.List() {
+		condition := c.(map[string]interface{})
+		conditions = append(conditions, fieldRelationshipCondition{
+			Field:    condition["field"].(string),
+			Operator: condition["operator"].(string),
+			Value:    condition["value"].(string),
+		})
+	}
+
+	return conditions
+}
+
+// customFieldOptionIDs maps the values of the custom field options in the
+// state to their ids. Options are matched by value rather than by position,
+// so that renaming or reordering options doesn't recreate them.
+func customFieldOptionIDs(d getter) map[string]int64 {
+	options := d.Get("custom_field_option")
+	if c, ok := d.(interface {
+		GetChange(string) (interface{}, interface{})
+	}); ok {
+		options, _ = c.GetChange("custom_field_option")
+	}
+
+	ids := make(map[string]int64)
+	list, _ := options.([]interface{})
+	for _, o := range list {
+		option, ok := o.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if id, _ := option["id"].(int); id != 0 {
+			ids[option["value"].(string)] = int64(id)
+		}
+	}
+
+	return ids
+}
+
 func resourceZendeskTicketFieldCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	zd := meta.(*client.Client)
 	return createTicketField(ctx, d, zd)
 }
 
-func createTicketField(ctx context.Context, d identifiableGetterSetter, zd client.TicketFieldAPI) diag.Diagnostics {
+func createTicketField(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	tf, err := unmarshalTicketField(d)
@@ -369,15 +657,20 @@ func createTicketField(ctx context.Context, d identifiableGetterSetter, zd clien
 		return diag.FromErr(err)
 	}
 
+	var data, result struct {
+		TicketField ticketField `json:"ticket_field"`
+	}
+	data.TicketField = tf
+
 	// Actual API request
-	tf, err = zd.CreateTicketField(ctx, tf)
+	err = postJSON(ctx, zd, "/ticket_fields.json", data, &result)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(fmt.Sprintf("%d", tf.ID))
+	d.SetId(fmt.Sprintf("%d", result.TicketField.ID))
 
-	err = marshalTicketField(tf, d)
+	err = marshalTicketField(result.TicketField, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -390,7 +683,7 @@ func resourceZendeskTicketFieldRead(ctx context.Context, d *schema.ResourceData,
 	return readTicketField(ctx, d, zd)
 }
 
-func readTicketField(ctx context.Context, d identifiableGetterSetter, zd client.TicketFieldAPI) diag.Diagnostics {
+func readTicketField(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
@@ -398,12 +691,15 @@ func readTicketField(ctx context.Context, d identifiableGetterSetter, zd client.
 		return diag.FromErr(err)
 	}
 
-	field, err := zd.GetTicketField(ctx, id)
+	var result struct {
+		TicketField ticketField `json:"ticket_field"`
+	}
+	err = getJSON(ctx, zd, fmt.Sprintf("/ticket_fields/%d.json", id), &result)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	err = marshalTicketField(field, d)
+	err = marshalTicketField(result.TicketField, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -416,7 +712,7 @@ func resourceZendeskTicketFieldUpdate(ctx context.Context, d *schema.ResourceDat
 	return updateTicketField(ctx, d, zd)
 }
 
-func updateTicketField(ctx context.Context, d identifiableGetterSetter, zd client.TicketFieldAPI) diag.Diagnostics {
+func updateTicketField(ctx context.Context, d identifiableGetterSetter, zd client.BaseAPI) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	tf, err := unmarshalTicketField(d)
@@ -429,13 +725,18 @@ func updateTicketField(ctx context.Context, d identifiableGetterSetter, zd clien
 		return diag.FromErr(err)
 	}
 
+	var data, result struct {
+		TicketField ticketField `json:"ticket_field"`
+	}
+	data.TicketField = tf
+
 	// Actual API request
-	tf, err = zd.UpdateTicketField(ctx, id, tf)
+	err = putJSON(ctx, zd, fmt.Sprintf("/ticket_fields/%d.json", id), data, &result)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	err = marshalTicketField(tf, d)
+	err = marshalTicketField(result.TicketField, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -448,7 +749,7 @@ func resourceZendeskTicketFieldDelete(ctx context.Context, d *schema.ResourceDat
 	return deleteTicketField(ctx, d, zd)
 }
 
-func deleteTicketField(ctx context.Context, d identifiable, zd client.TicketFieldAPI) diag.Diagnostics {
+func deleteTicketField(ctx context.Context, d identifiable, zd client.BaseAPI) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
@@ -456,7 +757,7 @@ func deleteTicketField(ctx context.Context, d identifiable, zd client.TicketFiel
 		return diag.FromErr(err)
 	}
 
-	err = zd.DeleteTicketField(ctx, id)
+	err = zd.Delete(ctx, fmt.Sprintf("/ticket_fields/%d.json", id))
 	if err != nil {
 		return diag.FromErr(err)
 	}
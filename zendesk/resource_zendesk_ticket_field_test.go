@@ -2,8 +2,10 @@ package zendesk
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"reflect"
 	"strconv"
 	"strings"
 	"testing"
@@ -11,6 +13,7 @@ import (
 
 	. "github.com/golang/mock/gomock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/nukosuke/go-zendesk/zendesk"
 	"github.com/nukosuke/go-zendesk/zendesk/mock"
@@ -63,7 +66,12 @@ func TestReadTicketField(t *testing.T) {
 		}},
 	}
 
-	m.EXPECT().GetTicketField(Any(), Any()).Return(field, nil)
+	out, err := json.Marshal(map[string]interface{}{"ticket_field": field})
+	if err != nil {
+		t.Fatalf("could not marshal the ticket field: %v", err)
+	}
+
+	m.EXPECT().Get(Any(), Eq("/ticket_fields/1234.json")).Return(out, nil)
 	if diags := readTicketField(context.Background(), gs, m); len(diags) != 0 {
 		t.Fatal("readTicketField returned an error")
 	}
@@ -85,6 +93,196 @@ func TestReadTicketField(t *testing.T) {
 	}
 }
 
+func TestReadLookupTicketField(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	gs := newIdentifiableGetterSetter()
+	gs.SetId("1234")
+
+	out := []byte(`{"ticket_field": {
+		"id": 1234,
+		"type": "lookup",
+		"title": "Requester's manager",
+		"relationship_target_type": "zen:user",
+		"relationship_filter": {
+			"all": [{"field": "role", "operator": "is", "value": "agent"}],
+			"any": []
+		}
+	}}`)
+
+	m.EXPECT().Get(Any(), Eq("/ticket_fields/1234.json")).Return(out, nil)
+	if diags := readTicketField(context.Background(), gs, m); len(diags) != 0 {
+		t.Fatalf("readTicketField returned an error: %v", diags)
+	}
+
+	if v := gs.Get("relationship_target_type"); v != "zen:user" {
+		t.Fatalf("relationship_target_type was %v. should have been zen:user", v)
+	}
+
+	filters := gs.Get("relationship_filter").([]map[string]interface{})
+	if len(filters) != 1 {
+		t.Fatalf("relationship_filter should have one block. was %v", filters)
+	}
+
+	expected := []map[string]interface{}{{"field": "role", "operator": "is", "value": "agent"}}
+	if !reflect.DeepEqual(filters[0]["all"], expected) {
+		t.Fatalf("relationship_filter all conditions were %v. should have been %v", filters[0]["all"], expected)
+	}
+}
+
+func TestUnmarshalLookupTicketField(t *testing.T) {
+	conditions := func(c ...map[string]interface{}) *schema.Set {
+		s := schema.NewSet(schema.HashResource(&schema.Resource{Schema: map[string]*schema.Schema{
+			"field":    {Type: schema.TypeString},
+			"operator": {Type: schema.TypeString},
+			"value":    {Type: schema.TypeString},
+		}}), nil)
+		for _, v := range c {
+			s.Add(v)
+		}
+		return s
+	}
+
+	d := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"type":                     "lookup",
+			"relationship_target_type": "zen:custom_object:car",
+			"relationship_filter": []interface{}{
+				map[string]interface{}{
+					"all": conditions(map[string]interface{}{"field": "custom_object_record.custom_fields.make", "operator": "is", "value": "toyota"}),
+					"any": conditions(),
+				},
+			},
+		},
+	}
+
+	tf, err := unmarshalTicketField(d)
+	if err != nil {
+		t.Fatalf("unmarshal returned an error: %v", err)
+	}
+
+	if tf.RelationshipTargetType != "zen:custom_object:car" {
+		t.Fatalf("relationship target type was %q", tf.RelationshipTargetType)
+	}
+
+	expected := &fieldRelationshipFilter{
+		All: []fieldRelationshipCondition{{Field: "custom_object_record.custom_fields.make", Operator: "is", Value: "toyota"}},
+		Any: []fieldRelationshipCondition{},
+	}
+	if !reflect.DeepEqual(tf.RelationshipFilter, expected) {
+		t.Fatalf("relationship filter was %v. should have been %v", tf.RelationshipFilter, expected)
+	}
+}
+
+func TestValidateTicketField(t *testing.T) {
+	cases := []struct {
+		name  string
+		attrs mapGetterSetter
+		valid bool
+	}{
+		{"plain text field", mapGetterSetter{"type": "text"}, true},
+		{"lookup field", mapGetterSetter{"type": "lookup", "relationship_target_type": "zen:user"}, true},
+		{"lookup field without target", mapGetterSetter{"type": "lookup"}, false},
+		{"target on text field", mapGetterSetter{"type": "text", "relationship_target_type": "zen:user"}, false},
+		{"filter on text field", mapGetterSetter{"type": "text", "relationship_filter": []interface{}{map[string]interface{}{}}}, false},
+		{"regexp field", mapGetterSetter{"type": "regexp", "regexp_for_validation": "^[0-9]+$"}, true},
+		{"invalid regexp", mapGetterSetter{"type": "regexp", "regexp_for_validation": "^[0-9+$"}, false},
+		{"regexp on text field", mapGetterSetter{"type": "text", "regexp_for_validation": "^[0-9]+$"}, false},
+		{"checkbox field with tag", mapGetterSetter{"type": "checkbox", "tag": "checked"}, true},
+		{"tag on text field", mapGetterSetter{"type": "text", "tag": "checked"}, false},
+		{"tagger field", mapGetterSetter{"type": "tagger", "custom_field_option": []interface{}{map[string]interface{}{"name": "Option 1", "value": "opt1"}}}, true},
+		{"tagger field without options", mapGetterSetter{"type": "tagger", "custom_field_option": []interface{}{}}, false},
+		{"multiselect field without options", mapGetterSetter{"type": "multiselect"}, false},
+		{"sub type on text field", mapGetterSetter{"type": "text", "sub_type_id": 1}, false},
+		{"zero sub type on text field", mapGetterSetter{"type": "text", "sub_type_id": 0}, true},
+	}
+
+	for _, c := range cases {
+		err := validateTicketField(c.attrs)
+		if c.valid && err != nil {
+			t.Fatalf("%s: should be valid but got %v", c.name, err)
+		}
+		if !c.valid && err == nil {
+			t.Fatalf("%s: should be invalid", c.name)
+		}
+	}
+}
+
+func TestTicketFieldDiffWithUnknownRelationshipTarget(t *testing.T) {
+	// a lookup field targeting a custom object created in the same apply
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"type":                     "lookup",
+		"title":                    "Vehicle",
+		"relationship_target_type": unknownConfigValue,
+	})
+	if _, err := resourceZendeskTicketField().Diff(context.Background(), nil, config, nil); err != nil {
+		t.Fatalf("plan with an unknown relationship_target_type returned an error: %v", err)
+	}
+
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"type":  "lookup",
+		"title": "Vehicle",
+	})
+	if _, err := resourceZendeskTicketField().Diff(context.Background(), nil, config, nil); err == nil {
+		t.Fatal("plan without relationship_target_type should have returned an error")
+	}
+}
+
+func TestUnmarshalTicketFieldMatchesOptionsByValue(t *testing.T) {
+	d := changeGetterSetter{
+		identifiableMapGetterSetter: &identifiableMapGetterSetter{
+			id: "1234",
+			mapGetterSetter: mapGetterSetter{
+				"type": "tagger",
+				// renamed opt2, moved it first, and added opt3. ids are still positional in the plan
+				"custom_field_option": []interface{}{
+					map[string]interface{}{"name": "Option Two", "value": "opt2", "id": 101},
+					map[string]interface{}{"name": "Option 1", "value": "opt1", "id": 102},
+					map[string]interface{}{"name": "Option 3", "value": "opt3", "id": 0},
+				},
+			},
+		},
+		old: mapGetterSetter{
+			"custom_field_option": []interface{}{
+				map[string]interface{}{"name": "Option 1", "value": "opt1", "id": 101},
+				map[string]interface{}{"name": "Option 2", "value": "opt2", "id": 102},
+			},
+		},
+	}
+
+	tf, err := unmarshalTicketField(d)
+	if err != nil {
+		t.Fatalf("unmarshal returned an error: %v", err)
+	}
+
+	expected := []zendesk.CustomFieldOption{
+		{ID: 102, Name: "Option Two", Value: "opt2"},
+		{ID: 101, Name: "Option 1", Value: "opt1"},
+		{ID: 0, Name: "Option 3", Value: "opt3"},
+	}
+	if !reflect.DeepEqual(tf.CustomFieldOptions, expected) {
+		t.Fatalf("custom field options were %v. should have been %v", tf.CustomFieldOptions, expected)
+	}
+}
+
+func TestUnmarshalTicketFieldRejectsDuplicateOptionValues(t *testing.T) {
+	d := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"type": "tagger",
+			"custom_field_option": []interface{}{
+				map[string]interface{}{"name": "Option 1", "value": "opt1", "id": 0},
+				map[string]interface{}{"name": "Option 1 again", "value": "opt1", "id": 0},
+			},
+		},
+	}
+
+	if _, err := unmarshalTicketField(d); err == nil {
+		t.Fatal("unmarshal should reject options sharing a value")
+	}
+}
+
 func TestDeleteTicketField(t *testing.T) {
 	ctrl := NewController(t)
 	defer ctrl.Finish()
@@ -94,7 +292,7 @@ func TestDeleteTicketField(t *testing.T) {
 		id: "12345",
 	}
 
-	m.EXPECT().DeleteTicketField(Any(), Eq(int64(12345))).Return(nil)
+	m.EXPECT().Delete(Any(), Eq("/ticket_fields/12345.json")).Return(nil)
 	if diags := deleteTicketField(context.Background(), i, m); len(diags) != 0 {
 		t.Fatal("readTicketField returned an error")
 	}
@@ -110,7 +308,7 @@ func TestUpdateTicketField(t *testing.T) {
 		mapGetterSetter: make(mapGetterSetter),
 	}
 
-	m.EXPECT().UpdateTicketField(Any(), Eq(int64(12345)), Any()).Return(zendesk.TicketField{}, nil)
+	m.EXPECT().Put(Any(), Eq("/ticket_fields/12345.json"), Any()).Return([]byte(`{"ticket_field": {"id": 12345}}`), nil)
 	if diags := updateTicketField(context.Background(), i, m); len(diags) != 0 {
 		t.Fatal("readTicketField returned an error")
 	}
@@ -125,11 +323,9 @@ func TestCreateTicketField(t *testing.T) {
 		mapGetterSetter: make(mapGetterSetter),
 	}
 
-	out := zendesk.TicketField{
-		ID: 12345,
-	}
+	out := []byte(`{"ticket_field": {"id": 12345}}`)
 
-	m.EXPECT().CreateTicketField(Any(), Any()).Return(out, nil)
+	m.EXPECT().Post(Any(), Eq("/ticket_fields.json"), Any()).Return(out, nil)
 	if diags := createTicketField(context.Background(), i, m); len(diags) != 0 {
 		t.Fatal("create ticket field returned an error")
 	}
@@ -243,8 +439,12 @@ func TestAccTicketFieldExample(t *testing.T) {
 					resource.TestCheckResourceAttr("zendesk_ticket_field.integer-field", "title", "Integer Field"),
 					resource.TestCheckResourceAttr("zendesk_ticket_field.regexp-field", "title", "Regexp Field"),
 					resource.TestCheckResourceAttr("zendesk_ticket_field.tagger-field", "title", "Tagger Field"),
+					resource.TestCheckResourceAttr("zendesk_ticket_field.tagger-field", "custom_field_option.0.value", "opt1"),
+					resource.TestCheckResourceAttr("zendesk_ticket_field.tagger-field", "custom_field_option.1.value", "opt2"),
 					resource.TestCheckResourceAttr("zendesk_ticket_field.text-field", "title", "Text Field"),
 					resource.TestCheckResourceAttr("zendesk_ticket_field.textarea-field", "title", "Textarea Field"),
+					resource.TestCheckResourceAttr("zendesk_ticket_field.lookup-field", "relationship_target_type", "zen:user"),
+					resource.TestCheckResourceAttr("zendesk_ticket_field.lookup-field", "relationship_filter.0.all.#", "1"),
 				),
 			},
 		},
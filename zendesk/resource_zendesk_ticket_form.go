@@ -9,6 +9,22 @@ import (
 	client "github.com/nukosuke/go-zendesk/zendesk"
 )
 
+// ticketFormAPI is the part of the client used by the ticket form resource.
+// Ticket fields are listed to find the system fields every form contains.
+type ticketFormAPI interface {
+	client.BaseAPI
+	client.TicketFormAPI
+}
+
+// Number of ticket fields fetched per page while looking for the system fields
+const ticketFieldsPerPage = 100
+
+// Types of the system ticket fields included in every ticket form, in the order they are added
+var ticketFormSystemFieldTypes = []string{
+	"subject",
+	"description",
+}
+
 // https://developer.zendesk.com/rest_api/docs/support/ticket_forms
 func resourceZendeskTicketForm() *schema.Resource {
 	return &schema.Resource{
@@ -70,9 +86,11 @@ func resourceZendeskTicketForm() *schema.Resource {
 				Type:        schema.TypeBool,
 				Optional:    true,
 			},
+			// this was a set. Sets and lists are both stored as arrays in the state,
+			// so states written with the set are read as they are, without a state upgrade.
 			"ticket_field_ids": {
-				Description: "ids of all ticket fields which are in this ticket form. The products use the order of the ids to show the field values in the tickets.",
-				Type:        schema.TypeSet,
+				Description: "ids of all ticket fields which are in this ticket form. The products use the order of the ids to show the field values in the tickets. The subject and description system fields are added at the top of the form unless they are listed.",
+				Type:        schema.TypeList,
 				Elem: &schema.Schema{
 					Type: schema.TypeInt,
 				},
@@ -85,7 +103,7 @@ func resourceZendeskTicketForm() *schema.Resource {
 				Default:     true,
 			},
 			"restricted_brand_ids": {
-				Description: "ids of all brands that this ticket form is restricted to.",
+				Description: "ids of all brands that this ticket form is restricted to. Use `zendesk_brand_ticket_forms` to assign the form to brands.",
 				Type:        schema.TypeSet,
 				Elem: &schema.Schema{
 					Type: schema.TypeInt,
@@ -142,23 +160,23 @@ func unmarshalTicketForm(d identifiableGetterSetter) (client.TicketForm, error)
 		tf.InAllBrands = v.(bool)
 	}
 
-	if v, ok := d.GetOk("ticket_field_ids"); ok {
-		ticketFieldIDs := v.(*schema.Set).List()
-		for _, ticketFieldID := range ticketFieldIDs {
-			tf.TicketFieldIDs = append(tf.TicketFieldIDs, int64(ticketFieldID.(int)))
-		}
-	}
+	tf.TicketFieldIDs = unmarshalTicketFormFieldIDs(d)
 
-	if v, ok := d.GetOk("restricted_brand_ids"); ok {
-		brandIDs := v.(*schema.Set).List()
-		for _, id := range brandIDs {
-			tf.TicketFieldIDs = append(tf.RestrictedBrandIDs, int64(id.(int)))
-		}
-	}
+	// restricted_brand_ids is managed by zendesk_brand_ticket_forms, so it is never sent
 
 	return tf, nil
 }
 
+func unmarshalTicketFormFieldIDs(d getter) []int64 {
+	var ids []int64
+	if v, ok := d.GetOk("ticket_field_ids"); ok {
+		for _, id := range v.([]interface{}) {
+			ids = append(ids, int64(id.(int)))
+		}
+	}
+	return ids
+}
+
 // marshalTicketField encodes the provided form into the provided resource data
 func marshalTicketForm(f client.TicketForm, d identifiableGetterSetter) error {
 	fields := map[string]interface{}{
@@ -182,7 +200,7 @@ func marshalTicketForm(f client.TicketForm, d identifiableGetterSetter) error {
 	return nil
 }
 
-func createTicketForm(ctx context.Context, d identifiableGetterSetter, zd client.TicketFormAPI) diag.Diagnostics {
+func createTicketForm(ctx context.Context, d identifiableGetterSetter, zd ticketFormAPI) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	tf, err := unmarshalTicketForm(d)
@@ -190,6 +208,14 @@ func createTicketForm(ctx context.Context, d identifiableGetterSetter, zd client
 		return diag.FromErr(err)
 	}
 
+	systemFieldIDs, err := getTicketFormSystemFieldIDs(ctx, zd)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	configured := tf.TicketFieldIDs
+	tf.TicketFieldIDs = withTicketFormSystemFields(configured, systemFieldIDs)
+
 	// Actual API request
 	tf, err = zd.CreateTicketForm(ctx, tf)
 	if err != nil {
@@ -199,6 +225,8 @@ func createTicketForm(ctx context.Context, d identifiableGetterSetter, zd client
 	// Patch from created resource
 	d.SetId(fmt.Sprintf("%d", tf.ID))
 
+	tf.TicketFieldIDs = withoutImplicitTicketFormSystemFields(tf.TicketFieldIDs, configured, systemFieldIDs)
+
 	err = marshalTicketForm(tf, d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -207,7 +235,7 @@ func createTicketForm(ctx context.Context, d identifiableGetterSetter, zd client
 	return diags
 }
 
-func readTicketForm(ctx context.Context, d identifiableGetterSetter, zd client.TicketFormAPI) diag.Diagnostics {
+func readTicketForm(ctx context.Context, d identifiableGetterSetter, zd ticketFormAPI) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	id, err := atoi64(d.Id())
@@ -220,15 +248,30 @@ func readTicketForm(ctx context.Context, d identifiableGetterSetter, zd client.T
 		return diag.FromErr(err)
 	}
 
+	systemFieldIDs, err := getTicketFormSystemFieldIDs(ctx, zd)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tf.TicketFieldIDs = withoutImplicitTicketFormSystemFields(tf.TicketFieldIDs, unmarshalTicketFormFieldIDs(d), systemFieldIDs)
+
 	err = marshalTicketForm(tf, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if !tf.InAllBrands && len(tf.RestrictedBrandIDs) == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("ticket form %d is not assigned to any brand", tf.ID),
+			Detail:   fmt.Sprintf("ticket form %q is not available in all brands. assign it to a brand with zendesk_brand_ticket_forms.", tf.Name),
+		})
+	}
+
 	return diags
 }
 
-func updateTicketForm(ctx context.Context, d identifiableGetterSetter, zd client.TicketFormAPI) diag.Diagnostics {
+func updateTicketForm(ctx context.Context, d identifiableGetterSetter, zd ticketFormAPI) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	tf, err := unmarshalTicketForm(d)
@@ -236,11 +279,21 @@ func updateTicketForm(ctx context.Context, d identifiableGetterSetter, zd client
 		return diag.FromErr(err)
 	}
 
+	systemFieldIDs, err := getTicketFormSystemFieldIDs(ctx, zd)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	configured := tf.TicketFieldIDs
+	tf.TicketFieldIDs = withTicketFormSystemFields(configured, systemFieldIDs)
+
 	tf, err = zd.UpdateTicketForm(ctx, tf.ID, tf)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	tf.TicketFieldIDs = withoutImplicitTicketFormSystemFields(tf.TicketFieldIDs, configured, systemFieldIDs)
+
 	err = marshalTicketForm(tf, d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -264,3 +317,67 @@ func deleteTicketForm(ctx context.Context, d identifiable, zd client.TicketFormA
 
 	return diags
 }
+
+// getTicketFormSystemFieldIDs returns the ids of the system fields every ticket form contains
+func getTicketFormSystemFieldIDs(ctx context.Context, zd client.BaseAPI) ([]int64, error) {
+	byType := make(map[string]int64, len(ticketFormSystemFieldTypes))
+
+	// the client only fetches the first page of ticket fields, so the pages are listed here
+	for page := 1; len(byType) < len(ticketFormSystemFieldTypes); page++ {
+		var result struct {
+			TicketFields []client.TicketField `json:"ticket_fields"`
+			client.Page
+		}
+		err := getJSON(ctx, zd, fmt.Sprintf("/ticket_fields.json?page=%d&per_page=%d", page, ticketFieldsPerPage), &result)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range ticketFormSystemFieldTypes {
+			for _, field := range result.TicketFields {
+				if _, ok := byType[t]; !ok && field.Type == t {
+					byType[t] = field.ID
+				}
+			}
+		}
+
+		if !result.HasNext() {
+			break
+		}
+	}
+
+	var ids []int64
+	for _, t := range ticketFormSystemFieldTypes {
+		if id, ok := byType[t]; ok {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, nil
+}
+
+// withTicketFormSystemFields adds the system fields missing from ids at the top of the form
+func withTicketFormSystemFields(ids, systemFieldIDs []int64) []int64 {
+	var out []int64
+	for _, id := range systemFieldIDs {
+		if !int64SliceContains(ids, id) {
+			out = append(out, id)
+		}
+	}
+
+	return append(out, ids...)
+}
+
+// withoutImplicitTicketFormSystemFields removes the system fields that were
+// added by the provider rather than listed in the configuration
+func withoutImplicitTicketFormSystemFields(ids, configured, systemFieldIDs []int64) []int64 {
+	out := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if int64SliceContains(systemFieldIDs, id) && !int64SliceContains(configured, id) {
+			continue
+		}
+		out = append(out, id)
+	}
+
+	return out
+}
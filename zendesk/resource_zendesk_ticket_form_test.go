@@ -2,16 +2,56 @@ package zendesk
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"testing"
 
 	. "github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/nukosuke/go-zendesk/zendesk"
 	"github.com/nukosuke/go-zendesk/zendesk/mock"
 )
 
+var testTicketFormSystemFields = []zendesk.TicketField{
+	{ID: 1, Type: "subject"},
+	{ID: 2, Type: "description"},
+	{ID: 3, Type: "status"},
+	{ID: 100, Type: "text"},
+}
+
+func expectTicketFormSystemFields(t *testing.T, m *mock.Client) {
+	body, err := json.Marshal(map[string]interface{}{"ticket_fields": testTicketFormSystemFields})
+	if err != nil {
+		t.Fatalf("could not marshal ticket fields: %v", err)
+	}
+	m.EXPECT().Get(Any(), Eq("/ticket_fields.json?page=1&per_page=100")).Return(body, nil)
+}
+
+func TestGetTicketFormSystemFieldIDsPaginates(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	InOrder(
+		m.EXPECT().Get(Any(), Eq("/ticket_fields.json?page=1&per_page=100")).Return([]byte(`{"ticket_fields": [{"id": 100, "type": "text"}, {"id": 2, "type": "description"}], "next_page": "https://example.zendesk.com/api/v2/ticket_fields.json?page=2"}`), nil),
+		m.EXPECT().Get(Any(), Eq("/ticket_fields.json?page=2&per_page=100")).Return([]byte(`{"ticket_fields": [{"id": 1, "type": "subject"}], "next_page": "https://example.zendesk.com/api/v2/ticket_fields.json?page=3"}`), nil),
+	)
+
+	ids, err := getTicketFormSystemFieldIDs(context.Background(), m)
+	if err != nil {
+		t.Fatalf("getTicketFormSystemFieldIDs returned an error: %v", err)
+	}
+
+	// the third page is not fetched once every system field is found
+	if !reflect.DeepEqual(ids, []int64{1, 2}) {
+		t.Fatalf("system field ids were %v. should have been [1 2]", ids)
+	}
+}
+
 func TestCreateTicketForm(t *testing.T) {
 	ctrl := NewController(t)
 	defer ctrl.Finish()
@@ -23,6 +63,7 @@ func TestCreateTicketForm(t *testing.T) {
 		Name: "foo",
 	}
 
+	expectTicketFormSystemFields(t, m)
 	m.EXPECT().CreateTicketForm(Any(), Any()).Return(out, nil)
 	if diags := createTicketForm(context.Background(), i, m); len(diags) != 0 {
 		t.Fatal("create ticket field returned an error")
@@ -61,15 +102,156 @@ func TestReadTicketForm(t *testing.T) {
 	i.SetId("12345")
 
 	expected := zendesk.TicketForm{
-		Name:     "foobar",
-		Position: int64(1),
+		Name:        "foobar",
+		Position:    int64(1),
+		InAllBrands: true,
 	}
 	m.EXPECT().GetTicketForm(Any(), Eq(int64(12345))).Return(expected, nil)
+	expectTicketFormSystemFields(t, m)
 	if diags := readTicketForm(context.Background(), i, m); len(diags) != 0 {
 		t.Fatalf("recieved an error when calling read ticket form: %v", diags)
 	}
 }
 
+func TestReadTicketFormWithoutBrand(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := newIdentifiableGetterSetter()
+	i.SetId("12345")
+
+	expected := zendesk.TicketForm{
+		ID:          12345,
+		Name:        "foobar",
+		InAllBrands: false,
+	}
+	m.EXPECT().GetTicketForm(Any(), Eq(int64(12345))).Return(expected, nil)
+	expectTicketFormSystemFields(t, m)
+	diags := readTicketForm(context.Background(), i, m)
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("read ticket form should warn about a form without brands. diags were %v", diags)
+	}
+}
+
+func TestCreateTicketFormAddsSystemFields(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		mapGetterSetter: mapGetterSetter{
+			"name":             "foo",
+			"ticket_field_ids": []interface{}{100, 3},
+		},
+	}
+
+	expectTicketFormSystemFields(t, m)
+	m.EXPECT().CreateTicketForm(Any(), Any()).DoAndReturn(func(_ context.Context, form zendesk.TicketForm) (zendesk.TicketForm, error) {
+		if !reflect.DeepEqual(form.TicketFieldIDs, []int64{1, 2, 100, 3}) {
+			t.Fatalf("ticket form was created with fields %v. should have been [1 2 100 3]", form.TicketFieldIDs)
+		}
+		form.ID = 12345
+		return form, nil
+	})
+
+	if diags := createTicketForm(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("create ticket form returned an error %v", diags)
+	}
+
+	if v := i.Get("ticket_field_ids"); !reflect.DeepEqual(v, []int64{100, 3}) {
+		t.Fatalf("ticket_field_ids was %v. should have been [100 3]", v)
+	}
+}
+
+func TestReadTicketFormKeepsListedSystemFields(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	m := mock.NewClient(ctrl)
+	i := &identifiableMapGetterSetter{
+		id: "12345",
+		mapGetterSetter: mapGetterSetter{
+			"ticket_field_ids": []interface{}{100, 2},
+		},
+	}
+
+	form := zendesk.TicketForm{
+		ID:             12345,
+		Name:           "foo",
+		InAllBrands:    true,
+		TicketFieldIDs: []int64{1, 100, 2, 3},
+	}
+	m.EXPECT().GetTicketForm(Any(), Eq(int64(12345))).Return(form, nil)
+	expectTicketFormSystemFields(t, m)
+
+	if diags := readTicketForm(context.Background(), i, m); len(diags) != 0 {
+		t.Fatalf("read ticket form returned an error %v", diags)
+	}
+
+	// subject is hidden since it was added by the provider, status is kept as drift
+	if v := i.Get("ticket_field_ids"); !reflect.DeepEqual(v, []int64{100, 2, 3}) {
+		t.Fatalf("ticket_field_ids was %v. should have been [100 2 3]", v)
+	}
+}
+
+func TestTicketFormReadsSetShapedState(t *testing.T) {
+	// ticket_field_ids was a set before it became an ordered list. Both are stored as
+	// arrays in the state, so the state needs no upgrade and keeps the order of the set.
+	v0 := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ticket_field_ids": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Optional: true,
+			},
+		},
+	}
+	d := v0.Data(nil)
+	d.SetId("47")
+	if err := d.Set("name", "foo"); err != nil {
+		t.Fatalf("could not set name: %v", err)
+	}
+	if err := d.Set("ticket_field_ids", []interface{}{3, 1, 2}); err != nil {
+		t.Fatalf("could not set ticket_field_ids: %v", err)
+	}
+
+	v0Type := v0.CoreConfigSchema().ImpliedType()
+	v0Value, err := schema.StateValueFromInstanceState(d.State(), v0Type)
+	if err != nil {
+		t.Fatalf("could not read the set shaped state: %v", err)
+	}
+	rawState, err := schema.StateValueToJSONMap(v0Value, v0Type)
+	if err != nil {
+		t.Fatalf("could not encode the set shaped state: %v", err)
+	}
+
+	r := resourceZendeskTicketForm()
+	value, err := schema.JSONMapToStateValue(rawState, r.CoreConfigSchema())
+	if err != nil {
+		t.Fatalf("set shaped state does not conform to the list schema: %v", err)
+	}
+	state, err := r.ShimInstanceStateFromValue(value)
+	if err != nil {
+		t.Fatalf("could not read the state with the list schema: %v", err)
+	}
+
+	// the ids keep the order they have in the state until the next refresh
+	expected := make([]interface{}, 0)
+	for _, id := range rawState["ticket_field_ids"].([]interface{}) {
+		expected = append(expected, int(id.(float64)))
+	}
+	if v := r.Data(state).Get("ticket_field_ids"); !reflect.DeepEqual(v, expected) {
+		t.Fatalf("ticket_field_ids was %v. should have been %v", v, expected)
+	}
+}
+
 func TestUnmarshalTicketForm(t *testing.T) {
 
 	d := &identifiableMapGetterSetter{
@@ -144,6 +326,8 @@ func TestAccTicketFormExample(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("zendesk_ticket_form.form-1", "name", "Form 1"),
 					resource.TestCheckResourceAttr("zendesk_ticket_form.form-2", "name", "Form 2"),
+					resource.TestCheckResourceAttrPair("zendesk_ticket_form.form-1", "ticket_field_ids.0", "data.zendesk_ticket_field.assignee", "id"),
+					resource.TestCheckResourceAttrPair("zendesk_ticket_form.form-1", "ticket_field_ids.8", "zendesk_ticket_field.integer-field", "id"),
 				),
 			},
 		},
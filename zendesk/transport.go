@@ -0,0 +1,47 @@
+package zendesk
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+type uploadContentTypeKey struct{}
+
+type methodKey struct{}
+
+// withUploadContentType returns a context for which uploads are sent with
+// the given Content-Type instead of the one set by go-zendesk.
+func withUploadContentType(ctx context.Context, contentType string) context.Context {
+	if contentType == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, uploadContentTypeKey{}, contentType)
+}
+
+// withMethod returns a context for which requests are sent with the given
+// HTTP method, e.g. PATCH, which go-zendesk doesn't expose.
+func withMethod(ctx context.Context, method string) context.Context {
+	return context.WithValue(ctx, methodKey{}, method)
+}
+
+// transport applies the request overrides carried by the request context.
+// go-zendesk always uploads as application/binary and has no exported PATCH.
+type transport struct {
+	base http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	contentType, ok := req.Context().Value(uploadContentTypeKey{}).(string)
+	if ok && strings.HasSuffix(req.URL.Path, "/uploads.json") {
+		req = req.Clone(req.Context())
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	if method, ok := req.Context().Value(methodKey{}).(string); ok && method != req.Method {
+		req = req.Clone(req.Context())
+		req.Method = method
+	}
+
+	return t.base.RoundTrip(req)
+}
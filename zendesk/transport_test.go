@@ -0,0 +1,83 @@
+package zendesk
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+type recordingTransport struct {
+	req *http.Request
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.req = req
+	return &http.Response{StatusCode: http.StatusCreated}, nil
+}
+
+func TestTransportOverridesUploadContentType(t *testing.T) {
+	base := &recordingTransport{}
+	transport := &transport{base: base}
+
+	ctx := withUploadContentType(context.Background(), "image/svg+xml")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://example.zendesk.com/api/v2/uploads.json?filename=logo.svg", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/binary")
+
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := base.req.Header.Get("Content-Type"); v != "image/svg+xml" {
+		t.Fatalf("upload was sent with Content-Type %s. should have been image/svg+xml", v)
+	}
+
+	if v := req.Header.Get("Content-Type"); v != "application/binary" {
+		t.Fatalf("the original request was modified. Content-Type was %s", v)
+	}
+}
+
+func TestTransportKeepsContentTypeOfOtherRequests(t *testing.T) {
+	base := &recordingTransport{}
+	transport := &transport{base: base}
+
+	ctx := withUploadContentType(context.Background(), "image/svg+xml")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, "https://example.zendesk.com/api/v2/brands/1.json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := base.req.Header.Get("Content-Type"); v != "application/json" {
+		t.Fatalf("request was sent with Content-Type %s. should have been application/json", v)
+	}
+}
+
+func TestTransportOverridesMethod(t *testing.T) {
+	base := &recordingTransport{}
+	transport := &transport{base: base}
+
+	ctx := withMethod(context.Background(), http.MethodPatch)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, "https://example.zendesk.com/api/v2/custom_objects/car.json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := base.req.Method; v != http.MethodPatch {
+		t.Fatalf("request was sent with method %s. should have been PATCH", v)
+	}
+
+	if v := req.Method; v != http.MethodPut {
+		t.Fatalf("the original request was modified. method was %s", v)
+	}
+}
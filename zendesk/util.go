@@ -1,11 +1,19 @@
 package zendesk
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
+	"net/http"
 	"os"
+	"regexp"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/nukosuke/go-zendesk/zendesk"
 )
 
 type getter interface {
@@ -91,6 +99,69 @@ func isValidFile() schema.SchemaValidateFunc {
 	}
 }
 
+func isValidDuration() schema.SchemaValidateFunc {
+	return func(i interface{}, key string) (strings []string, errs []error) {
+		v, ok := i.(string)
+		if !ok {
+			errs = append(errs, fmt.Errorf("expected type of %s to be string", key))
+			return
+		}
+
+		if _, err := time.ParseDuration(v); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %v", key, err))
+		}
+
+		return
+	}
+}
+
+// isValidIPRange validates an IP address or a CIDR range. Host bits must not be set in ranges,
+// as "10.0.0.1/8" is more likely a typo than the intended "10.0.0.0/8".
+func isValidIPRange() schema.SchemaValidateFunc {
+	return func(i interface{}, key string) (strings []string, errs []error) {
+		v, ok := i.(string)
+		if !ok {
+			errs = append(errs, fmt.Errorf("expected type of %s to be string", key))
+			return
+		}
+
+		if net.ParseIP(v) != nil {
+			return
+		}
+
+		ip, ipNet, err := net.ParseCIDR(v)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %s is neither an IP address nor a CIDR range", key, v))
+			return
+		}
+
+		if !ip.Equal(ipNet.IP) {
+			errs = append(errs, fmt.Errorf("%s: %s has host bits set. did you mean %s?", key, v, ipNet))
+		}
+
+		return
+	}
+}
+
+var certificateFingerprintRegexp = regexp.MustCompile(`^[0-9A-Fa-f]{2}(:[0-9A-Fa-f]{2}){19}((:[0-9A-Fa-f]{2}){12})?$`)
+
+// isValidCertificateFingerprint validates a SHA1 or SHA256 certificate fingerprint as colon separated hex bytes
+func isValidCertificateFingerprint() schema.SchemaValidateFunc {
+	return func(i interface{}, key string) (strings []string, errs []error) {
+		v, ok := i.(string)
+		if !ok {
+			errs = append(errs, fmt.Errorf("expected type of %s to be string", key))
+			return
+		}
+
+		if !certificateFingerprintRegexp.MatchString(v) {
+			errs = append(errs, fmt.Errorf("%s: %s is not a SHA1 or SHA256 fingerprint, e.g. 9A:2B:...:7F", key, v))
+		}
+
+		return
+	}
+}
+
 func setSchemaFields(d setter, m map[string]interface{}) error {
 	for k, v := range m {
 		err := d.Set(k, v)
@@ -105,3 +176,73 @@ func setSchemaFields(d setter, m map[string]interface{}) error {
 func atoi64(anum string) (int64, error) {
 	return strconv.ParseInt(anum, 10, 64)
 }
+
+// getJSON requests an endpoint not yet implemented by the client and decodes the response into out
+func getJSON(ctx context.Context, zd client.BaseAPI, path string, out interface{}) error {
+	body, err := zd.Get(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	return decodeJSON(body, out)
+}
+
+// postJSON sends data to an endpoint not yet implemented by the client and decodes the response into out
+func postJSON(ctx context.Context, zd client.BaseAPI, path string, data, out interface{}) error {
+	body, err := zd.Post(ctx, path, data)
+	if err != nil {
+		return err
+	}
+
+	return decodeJSON(body, out)
+}
+
+// putJSON sends data to an endpoint not yet implemented by the client and decodes the response into out
+func putJSON(ctx context.Context, zd client.BaseAPI, path string, data, out interface{}) error {
+	body, err := zd.Put(ctx, path, data)
+	if err != nil {
+		return err
+	}
+
+	return decodeJSON(body, out)
+}
+
+// patchJSON sends data with PATCH to an endpoint not yet implemented by the client and decodes the response into out
+func patchJSON(ctx context.Context, zd client.BaseAPI, path string, data, out interface{}) error {
+	return putJSON(withMethod(ctx, http.MethodPatch), zd, path, data, out)
+}
+
+func decodeJSON(body []byte, out interface{}) error {
+	// some endpoints respond with an empty body, e.g. "204 No Content"
+	if out == nil || len(body) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(body, out)
+}
+
+// isNotFound reports whether err is a "404 Not Found" response from Zendesk
+func isNotFound(err error) bool {
+	var zdErr client.Error
+	return errors.As(err, &zdErr) && zdErr.Status() == http.StatusNotFound
+}
+
+func int64SliceContains(s []int64, v int64) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+// int64SliceRemove returns a copy of s without any occurrence of v
+func int64SliceRemove(s []int64, v int64) []int64 {
+	out := make([]int64, 0, len(s))
+	for _, e := range s {
+		if e != v {
+			out = append(out, e)
+		}
+	}
+	return out
+}
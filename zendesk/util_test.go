@@ -3,9 +3,14 @@ package zendesk
 import (
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	client "github.com/nukosuke/go-zendesk/zendesk"
 )
 
 func TestIsValidFile(t *testing.T) {
@@ -26,6 +31,84 @@ func TestIsValidFile(t *testing.T) {
 	}
 }
 
+func TestIsValidDuration(t *testing.T) {
+	v := isValidDuration()
+	_, errs := v("10m", "verification_timeout")
+	if len(errs) != 0 {
+		t.Fatalf("is Valid returned an error")
+	}
+
+	_, errs = v("ten minutes", "verification_timeout")
+	if len(errs) == 0 {
+		t.Fatalf("is Valid did not return an error for an invalid duration")
+	}
+}
+
+func TestIsValidIPRange(t *testing.T) {
+	v := isValidIPRange()
+	for _, r := range []string{"192.0.2.10", "192.0.2.0/24", "2001:db8::/32", "2001:db8::1"} {
+		if _, errs := v(r, "ip_ranges"); len(errs) != 0 {
+			t.Fatalf("is Valid returned an error for %s: %v", r, errs)
+		}
+	}
+
+	for _, r := range []string{"192.0.2.300", "192.0.2.0/33", "192.0.2.1/24", "office", "192.0.2.1-192.0.2.9"} {
+		if _, errs := v(r, "ip_ranges"); len(errs) == 0 {
+			t.Fatalf("is Valid did not return an error for %s", r)
+		}
+	}
+}
+
+func TestIsValidCertificateFingerprint(t *testing.T) {
+	v := isValidCertificateFingerprint()
+	sha1 := "9A:2B:3C:4D:5E:6F:70:81:92:A3:B4:C5:D6:E7:F8:09:1A:2B:3C:4D"
+	sha256 := sha1 + ":5E:6F:70:81:92:A3:B4:C5:D6:E7:F8:09"
+	for _, f := range []string{sha1, strings.ToLower(sha1), sha256} {
+		if _, errs := v(f, "fingerprint"); len(errs) != 0 {
+			t.Fatalf("is Valid returned an error for %s: %v", f, errs)
+		}
+	}
+
+	for _, f := range []string{sha1[:len(sha1)-3], sha1 + ":5E", strings.ReplaceAll(sha1, ":", ""), strings.Replace(sha1, "9A", "9G", 1)} {
+		if _, errs := v(f, "fingerprint"); len(errs) == 0 {
+			t.Fatalf("is Valid did not return an error for %s", f)
+		}
+	}
+}
+
+// changeGetterSetter adds the prior state to identifiableMapGetterSetter, like schema.ResourceData
+type changeGetterSetter struct {
+	*identifiableMapGetterSetter
+	old mapGetterSetter
+}
+
+func (c changeGetterSetter) GetChange(k string) (interface{}, interface{}) {
+	return c.old.Get(k), c.Get(k)
+}
+
+func (c changeGetterSetter) HasChanges(keys ...string) bool {
+	for _, k := range keys {
+		if !reflect.DeepEqual(c.old.Get(k), c.Get(k)) {
+			return true
+		}
+	}
+	return false
+}
+
+// rawConfigGetterSetter adds the configuration to changeGetterSetter, like schema.ResourceData
+type rawConfigGetterSetter struct {
+	changeGetterSetter
+	config cty.Value
+}
+
+func (r rawConfigGetterSetter) GetRawConfig() cty.Value {
+	return r.config
+}
+
+// unknownConfigValue marks a raw configuration value as known only after apply.
+// It is hcl2shim.UnknownVariableValue, which is internal to the SDK.
+const unknownConfigValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
 func readExampleConfig(t *testing.T, filename string) string {
 	dir, err := filepath.Abs("../examples")
 	if err != nil {
@@ -52,3 +135,23 @@ func concatExampleConfig(t *testing.T, configs ...string) string {
 
 	return builder.String()
 }
+
+func TestIsNotFound(t *testing.T) {
+	notFound := client.NewError(nil, &http.Response{StatusCode: http.StatusNotFound})
+	if !isNotFound(notFound) {
+		t.Fatal("isNotFound returned false for a 404 response")
+	}
+
+	if !isNotFound(fmt.Errorf("wrapped: %w", notFound)) {
+		t.Fatal("isNotFound returned false for a wrapped 404 response")
+	}
+
+	forbidden := client.NewError(nil, &http.Response{StatusCode: http.StatusForbidden})
+	if isNotFound(forbidden) {
+		t.Fatal("isNotFound returned true for a 403 response")
+	}
+
+	if isNotFound(nil) {
+		t.Fatal("isNotFound returned true for a nil error")
+	}
+}